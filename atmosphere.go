@@ -0,0 +1,174 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrAltitude is returned for altitudes and pressures outside the
+// International Standard Atmosphere, from -5000 m to 84852 m.
+var ErrAltitude = errors.New("measurements: outside the standard atmosphere")
+
+const (
+	// StandardGravity is g0, in m/s².
+	StandardGravity = 9.80665
+	// SpecificGasConstantAir is the gas constant of dry air, in J/(kg·K).
+	SpecificGasConstantAir = 287.05287
+
+	isaMinAltitude = -5000.0
+	isaMaxAltitude = 84852.0
+)
+
+// isaLayer is a layer of the standard atmosphere in which temperature
+// changes linearly with geopotential altitude.
+type isaLayer struct {
+	base        float64 // altitude of the bottom of the layer in metres
+	lapseRate   float64 // change in temperature in K/m
+	temperature float64 // temperature at base in kelvins
+	pressure    float64 // pressure at base in pascals
+}
+
+// isaLayers holds the layers of the 1976 US Standard Atmosphere, which the
+// ISA matches up to 84852 m. The base pressures are filled in by init.
+var isaLayers = []isaLayer{
+	{base: isaMinAltitude, lapseRate: -0.0065},
+	{base: 11000, lapseRate: 0},
+	{base: 20000, lapseRate: 0.001},
+	{base: 32000, lapseRate: 0.0028},
+	{base: 47000, lapseRate: 0},
+	{base: 51000, lapseRate: -0.0028},
+	{base: 71000, lapseRate: -0.002},
+}
+
+func init() {
+	// Sea level is 288.15 K and 101325 Pa, inside the first layer.
+	first := &isaLayers[0]
+	first.temperature = 288.15 + first.lapseRate*first.base
+	first.pressure = isaLayerPressure(isaLayer{temperature: 288.15, pressure: 101325, lapseRate: first.lapseRate}, first.base)
+	for i := 1; i < len(isaLayers); i++ {
+		below, layer := isaLayers[i-1], &isaLayers[i]
+		layer.temperature = below.temperature + below.lapseRate*(layer.base-below.base)
+		layer.pressure = isaLayerPressure(below, layer.base-below.base)
+	}
+}
+
+// isaLayerPressure returns the pressure height metres above the base of
+// layer.
+func isaLayerPressure(layer isaLayer, height float64) float64 {
+	if layer.lapseRate == 0 {
+		return layer.pressure * math.Exp(-StandardGravity*height/(SpecificGasConstantAir*layer.temperature))
+	}
+	temperature := layer.temperature + layer.lapseRate*height
+	return layer.pressure * math.Pow(layer.temperature/temperature, StandardGravity/(SpecificGasConstantAir*layer.lapseRate))
+}
+
+// ISAConditions is the state of the International Standard Atmosphere at
+// a geopotential altitude in metres.
+type ISAConditions struct {
+	Altitude    float64
+	Pressure    Pressure
+	Temperature Temperature
+	// Density is in kg/m³.
+	Density float64
+}
+
+// ISA returns the standard pressure, in pascals, temperature, in kelvins,
+// and density at altitude metres above mean sea level.
+func ISA(altitude float64) (ISAConditions, error) {
+	if math.IsNaN(altitude) || altitude < isaMinAltitude || altitude > isaMaxAltitude {
+		return ISAConditions{}, fmt.Errorf("%w: %v m", ErrAltitude, altitude)
+	}
+	layer := isaLayers[0]
+	for _, l := range isaLayers[1:] {
+		if altitude < l.base {
+			break
+		}
+		layer = l
+	}
+
+	height := altitude - layer.base
+	pressure := isaLayerPressure(layer, height)
+	temperature := layer.temperature + layer.lapseRate*height
+	return ISAConditions{
+		Altitude:    altitude,
+		Pressure:    FromPascal(pressure),
+		Temperature: FromKelvin(temperature),
+		Density:     pressure / (SpecificGasConstantAir * temperature),
+	}, nil
+}
+
+// PressureAltitude returns the altitude, in metres, at which the standard
+// atmosphere has pressure p. It is the altitude an altimeter set to
+// 1013.25 hPa shows.
+func PressureAltitude(p Pressure) (float64, error) {
+	pa, err := pascals(p)
+	if err != nil {
+		return 0, err
+	}
+	return isaAltitude(pa)
+}
+
+func isaAltitude(pa float64) (float64, error) {
+	top := isaLayerPressure(isaLayers[len(isaLayers)-1], isaMaxAltitude-isaLayers[len(isaLayers)-1].base)
+	if pa > isaLayers[0].pressure || pa < top {
+		return 0, fmt.Errorf("%w: %v Pa", ErrAltitude, pa)
+	}
+	layer := isaLayers[0]
+	for _, l := range isaLayers[1:] {
+		if pa > l.pressure {
+			break
+		}
+		layer = l
+	}
+
+	if layer.lapseRate == 0 {
+		return layer.base - SpecificGasConstantAir*layer.temperature/StandardGravity*math.Log(pa/layer.pressure), nil
+	}
+	temperature := layer.temperature / math.Pow(pa/layer.pressure, SpecificGasConstantAir*layer.lapseRate/StandardGravity)
+	return layer.base + (temperature-layer.temperature)/layer.lapseRate, nil
+}
+
+// AltitudeAbove returns the height, in metres, of the pressure p above the
+// place whose pressure is reference, as an altimeter set to reference
+// shows. With the QNH as reference it is the altitude above mean sea
+// level; with the QFE it is the height above the aerodrome.
+func AltitudeAbove(p, reference Pressure) (float64, error) {
+	altitude, err := PressureAltitude(p)
+	if err != nil {
+		return 0, err
+	}
+	zero, err := PressureAltitude(reference)
+	if err != nil {
+		return 0, err
+	}
+	return altitude - zero, nil
+}
+
+// QNH reduces qfe, the pressure measured at elevation metres above mean
+// sea level, to the sea level pressure an altimeter is set to.
+func QNH(qfe Pressure, elevation float64) (Pressure, error) {
+	altitude, err := PressureAltitude(qfe)
+	if err != nil {
+		return nil, err
+	}
+	return isaPressure(altitude - elevation)
+}
+
+// QFE returns the pressure at elevation metres above mean sea level when
+// the sea level pressure is qnh.
+func QFE(qnh Pressure, elevation float64) (Pressure, error) {
+	altitude, err := PressureAltitude(qnh)
+	if err != nil {
+		return nil, err
+	}
+	return isaPressure(altitude + elevation)
+}
+
+func isaPressure(altitude float64) (Pressure, error) {
+	conditions, err := ISA(altitude)
+	if err != nil {
+		return nil, err
+	}
+	return conditions.Pressure, nil
+}
@@ -0,0 +1,122 @@
+package measurements_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+// The expected values are from the standard atmosphere tables by geopotential
+// altitude.
+func Test_ISA(t *testing.T) {
+	tests := []struct {
+		name        string
+		altitude    float64
+		pressure    string
+		temperature string
+		density     float64
+	}{
+		{name: "Sea level", altitude: 0, pressure: "1013.25 hPa", temperature: "15.00 °C", density: 1.2250},
+		{name: "1000 m", altitude: 1000, pressure: "898.75 hPa", temperature: "8.50 °C", density: 1.1117},
+		{name: "5000 m", altitude: 5000, pressure: "540.20 hPa", temperature: "-17.50 °C", density: 0.73643},
+		{name: "Tropopause", altitude: 11000, pressure: "226.32 hPa", temperature: "-56.50 °C", density: 0.36392},
+		{name: "Stratosphere", altitude: 15000, pressure: "120.45 hPa", temperature: "-56.50 °C", density: 0.19367},
+		{name: "20000 m", altitude: 20000, pressure: "54.75 hPa", temperature: "-56.50 °C", density: 0.088035},
+		{name: "32000 m", altitude: 32000, pressure: "8.68 hPa", temperature: "-44.50 °C", density: 0.013225},
+		{name: "Below sea level", altitude: -500, pressure: "1074.78 hPa", temperature: "18.25 °C", density: 1.2850},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.ISA(tt.altitude)
+			if err != nil {
+				t.Fatalf("ISA() error = %v", err)
+			}
+			if p := got.Pressure.ToHectopascal(); !reflect.DeepEqual(p.String(), tt.pressure) {
+				t.Errorf("ISA() pressure = %v, want %v", p, tt.pressure)
+			}
+			if temperature := got.Temperature.ToCelsius(); !reflect.DeepEqual(temperature.String(), tt.temperature) {
+				t.Errorf("ISA() temperature = %v, want %v", temperature, tt.temperature)
+			}
+			if math.Abs(got.Density-tt.density) > 5e-4*tt.density {
+				t.Errorf("ISA() density = %v, want %v", got.Density, tt.density)
+			}
+		})
+	}
+
+	for _, altitude := range []float64{-5001, 90000, math.NaN()} {
+		if _, err := measurements.ISA(altitude); !errors.Is(err, measurements.ErrAltitude) {
+			t.Errorf("ISA(%v) error = %v, want %v", altitude, err, measurements.ErrAltitude)
+		}
+	}
+}
+
+func Test_PressureAltitude(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       measurements.Pressure
+		want    float64
+		wantErr error
+	}{
+		{name: "Sea level", p: measurements.FromHectopascal(1013.25), want: 0},
+		{name: "Inches of mercury", p: measurements.FromInchOfMercury(29.92), want: 0},
+		{name: "Troposphere", p: measurements.FromHectopascal(898.75), want: 1000},
+		{name: "Flight level 350", p: measurements.FromHectopascal(238.42), want: 10668},
+		{name: "Stratosphere", p: measurements.FromHectopascal(54.75), want: 20000},
+		{name: "Vacuum", p: measurements.FromPascal(0), wantErr: measurements.ErrAltitude},
+		{name: "Differential", p: measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 1), wantErr: measurements.ErrDifferential},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.PressureAltitude(tt.p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PressureAltitude() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && math.Abs(got-tt.want) > 1 {
+				t.Errorf("PressureAltitude() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_AltitudeAbove(t *testing.T) {
+	qnh := measurements.FromHectopascal(1020)
+	qfe, err := measurements.QFE(qnh, 300)
+	if err != nil {
+		t.Fatalf("QFE() error = %v", err)
+	}
+	if got, want := qfe.ToHectopascal().String(), "984.28 hPa"; got != want {
+		t.Errorf("QFE() = %v, want %v", got, want)
+	}
+
+	got, err := measurements.QNH(qfe, 300)
+	if err != nil {
+		t.Fatalf("QNH() error = %v", err)
+	}
+	if got, want := got.ToHectopascal().String(), "1020.00 hPa"; got != want {
+		t.Errorf("QNH() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name      string
+		reference measurements.Pressure
+		want      float64
+	}{
+		{name: "QNH", reference: qnh, want: 300},
+		{name: "QFE", reference: qfe, want: 0},
+		{name: "Standard setting", reference: measurements.FromHectopascal(1013.25), want: 244},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.AltitudeAbove(qfe, tt.reference)
+			if err != nil {
+				t.Fatalf("AltitudeAbove() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 0.5 {
+				t.Errorf("AltitudeAbove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package measurements
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// binaryVersion is written first in every binary encoding so the format can
+// evolve while old data stays readable.
+const binaryVersion = 1
+
+// binaryLength is the size of a single encoded measurement: version, kind,
+// unit and value.
+const binaryLength = 1 + 1 + 4 + 8
+
+var ErrUnsupportedVersion = errors.New("measurements: unsupported binary version")
+
+func init() {
+	gob.RegisterName("measurements.Mass", &mass{})
+	gob.RegisterName("measurements.Pressure", &pressure{})
+	gob.RegisterName("measurements.Volume", &volume{})
+	gob.RegisterName("measurements.Temperature", &temperature{})
+}
+
+// marshalMeasurementBinary fails with ErrUnknownUnit for units registered
+// at run time, whose enum value would mean another unit to another process.
+func marshalMeasurementBinary(kind Kind, m Measurement) ([]byte, error) {
+	code, ok := unitCode(kind, m.measurementUnit())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, m.measurementUnit())
+	}
+
+	data := make([]byte, binaryLength)
+	data[0] = binaryVersion
+	data[1] = byte(kind)
+	binary.BigEndian.PutUint32(data[2:], uint32(code))
+	binary.BigEndian.PutUint64(data[6:], math.Float64bits(m.Value()))
+	return data, nil
+}
+
+func unmarshalMeasurementBinary(kind Kind, data []byte) (Unit, float64, error) {
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("%w: empty %s", ErrSyntax, kind)
+	}
+	if data[0] != binaryVersion {
+		return nil, 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+	}
+	if len(data) != binaryLength {
+		return nil, 0, fmt.Errorf("%w: %s is %d bytes, want %d", ErrSyntax, kind, len(data), binaryLength)
+	}
+	if Kind(data[1]) != kind {
+		return nil, 0, fmt.Errorf("%w: decoding %s into %s", ErrSyntax, Kind(data[1]), kind)
+	}
+
+	code := int32(binary.BigEndian.Uint32(data[2:]))
+	unit, ok := unitFromCode(kind, code)
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %s %d", ErrUnknownUnit, kind, code)
+	}
+	return unit, math.Float64frombits(binary.BigEndian.Uint64(data[6:])), nil
+}
+
+func (s MassValue) MarshalBinary() ([]byte, error) {
+	if s.Mass == nil {
+		return []byte{}, nil
+	}
+	return marshalMeasurementBinary(MassKind, s.Mass)
+}
+
+func (s *MassValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		s.Mass = nil
+		return nil
+	}
+	unit, value, err := unmarshalMeasurementBinary(MassKind, data)
+	if err != nil {
+		return err
+	}
+	s.Mass = NewMass(unit.(MassUnit), value)
+	return nil
+}
+
+func (s PressureValue) MarshalBinary() ([]byte, error) {
+	if s.Pressure == nil {
+		return []byte{}, nil
+	}
+	return marshalMeasurementBinary(PressureKind, s.Pressure)
+}
+
+func (s *PressureValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		s.Pressure = nil
+		return nil
+	}
+	unit, value, err := unmarshalMeasurementBinary(PressureKind, data)
+	if err != nil {
+		return err
+	}
+	s.Pressure = NewPressure(unit.(PressureUnit), value)
+	return nil
+}
+
+func (s VolumeValue) MarshalBinary() ([]byte, error) {
+	if s.Volume == nil {
+		return []byte{}, nil
+	}
+	return marshalMeasurementBinary(VolumeKind, s.Volume)
+}
+
+func (s *VolumeValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		s.Volume = nil
+		return nil
+	}
+	unit, value, err := unmarshalMeasurementBinary(VolumeKind, data)
+	if err != nil {
+		return err
+	}
+	s.Volume = NewVolume(unit.(VolumeType), value)
+	return nil
+}
+
+func (s TemperatureValue) MarshalBinary() ([]byte, error) {
+	if s.Temperature == nil {
+		return []byte{}, nil
+	}
+	return marshalMeasurementBinary(TemperatureKind, s.Temperature)
+}
+
+func (s *TemperatureValue) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		s.Temperature = nil
+		return nil
+	}
+	unit, value, err := unmarshalMeasurementBinary(TemperatureKind, data)
+	if err != nil {
+		return err
+	}
+	s.Temperature = NewTemperature(unit.(TemperatureUnit), value)
+	return nil
+}
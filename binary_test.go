@@ -0,0 +1,189 @@
+package measurements_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name  string
+		input measurements.Measurement
+	}{
+		{name: "Mass", input: measurements.FromOunce(3.25)},
+		{name: "Pressure", input: measurements.FromTorr(760)},
+		{name: "Volume", input: measurements.FromImperialQuart(0.1)},
+		{name: "Temperature", input: measurements.FromFahrenheit(-459.67)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.input.(interface{ MarshalBinary() ([]byte, error) }).MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != 14 {
+				t.Errorf("MarshalBinary() length = %d, want 14", len(data))
+			}
+
+			var got measurements.Measurement
+			switch tt.input.(type) {
+			case measurements.Mass:
+				var v measurements.MassValue
+				err = v.UnmarshalBinary(data)
+				got = v.Mass
+			case measurements.Pressure:
+				var v measurements.PressureValue
+				err = v.UnmarshalBinary(data)
+				got = v.Pressure
+			case measurements.Volume:
+				var v measurements.VolumeValue
+				err = v.UnmarshalBinary(data)
+				got = v.Volume
+			case measurements.Temperature:
+				var v measurements.TemperatureValue
+				err = v.UnmarshalBinary(data)
+				got = v.Temperature
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Value() != tt.input.Value() || got.String() != tt.input.String() {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, tt.input)
+			}
+		})
+	}
+}
+
+// The encoding stores the enum value of the unit, so these bytes pin the
+// order of the units in quantities.json: new units must be appended.
+func Test_MarshalBinary_golden(t *testing.T) {
+	tests := []struct {
+		name  string
+		input encoding.BinaryMarshaler
+		into  encoding.BinaryUnmarshaler
+		want  string
+	}{
+		{name: "Ounce", input: measurements.MassValue{Mass: measurements.FromOunce(2.5)}, into: &measurements.MassValue{}, want: "01 00 00000003 4004000000000000"},
+		{name: "Slug", input: measurements.MassValue{Mass: measurements.FromSlug(2.5)}, into: &measurements.MassValue{}, want: "01 00 00000011 4004000000000000"},
+		{name: "Pascal", input: measurements.PressureValue{Pressure: measurements.FromPascal(2.5)}, into: &measurements.PressureValue{}, want: "01 01 00000002 4004000000000000"},
+		{name: "Kip per square inch", input: measurements.PressureValue{Pressure: measurements.FromKilopoundForcePerSquareInch(2.5)}, into: &measurements.PressureValue{}, want: "01 01 00000011 4004000000000000"},
+		{name: "Imperial gallon", input: measurements.VolumeValue{Volume: measurements.FromImperialGallon(2.5)}, into: &measurements.VolumeValue{}, want: "01 02 0000000b 4004000000000000"},
+		{name: "Kelvin", input: measurements.TemperatureValue{Temperature: measurements.FromKelvin(2.5)}, into: &measurements.TemperatureValue{}, want: "01 03 00000002 4004000000000000"},
+		{name: "Newton", input: measurements.TemperatureValue{Temperature: measurements.FromNewton(2.5)}, into: &measurements.TemperatureValue{}, want: "01 03 00000007 4004000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.input.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(data); got != strings.ReplaceAll(tt.want, " ", "") {
+				t.Errorf("MarshalBinary() = %s, want %s", got, tt.want)
+			}
+
+			want, _ := hex.DecodeString(strings.ReplaceAll(tt.want, " ", ""))
+			if err := tt.into.UnmarshalBinary(want); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := fmt.Sprint(tt.into), fmt.Sprint(tt.input); got != want {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalBinary_Errors(t *testing.T) {
+	data, err := measurements.MassValue{Mass: measurements.FromGram(1)}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p measurements.PressureValue
+	if err := p.UnmarshalBinary(data); !errors.Is(err, measurements.ErrSyntax) {
+		t.Errorf("UnmarshalBinary() wrong kind error = %v, want %v", err, measurements.ErrSyntax)
+	}
+
+	data[0] = 99
+	var m measurements.MassValue
+	if err := m.UnmarshalBinary(data); !errors.Is(err, measurements.ErrUnsupportedVersion) {
+		t.Errorf("UnmarshalBinary() version error = %v, want %v", err, measurements.ErrUnsupportedVersion)
+	}
+}
+
+func Test_Gob(t *testing.T) {
+	type sample struct {
+		Mass     measurements.Mass
+		Pressure measurements.PressureValue
+		Missing  measurements.VolumeValue
+	}
+	input := sample{
+		Mass:     measurements.FromPound(12.5),
+		Pressure: measurements.PressureValue{Pressure: measurements.FromPascal(99000)},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(input); err != nil {
+		t.Fatal(err)
+	}
+	var got sample
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Mass.String() != input.Mass.String() {
+		t.Errorf("Mass = %v, want %v", got.Mass, input.Mass)
+	}
+	if got.Pressure.String() != input.Pressure.String() {
+		t.Errorf("Pressure = %v, want %v", got.Pressure, input.Pressure)
+	}
+	if got.Missing.Volume != nil {
+		t.Errorf("Missing = %v, want nil", got.Missing)
+	}
+}
+
+// Prefixed units are numbered in the order they are first parsed, so their
+// numbers must never reach encoded data: the same bytes would decode as a
+// different unit in a process that parsed them in another order.
+func Test_MarshalBinary_prefixedUnits(t *testing.T) {
+	for _, order := range [][]string{{"2 GPa", "3 mPa"}, {"3 mPa", "2 GPa"}} {
+		t.Run(order[0]+" first", func(t *testing.T) {
+			measurements.KeepUnits(t)
+
+			for _, input := range order {
+				p, err := measurements.ParsePressure(input)
+				if err != nil {
+					t.Fatalf("ParsePressure() error = %v", err)
+				}
+				if _, err := (measurements.PressureValue{Pressure: p}).MarshalBinary(); !errors.Is(err, measurements.ErrUnknownUnit) {
+					t.Errorf("MarshalBinary(%v) error = %v, want %v", p, err, measurements.ErrUnknownUnit)
+				}
+				var buf bytes.Buffer
+				if err := measurements.NewEncoder(&buf, measurements.PressureKind).WriteBlock(p.Unit(), []float64{p.Value()}); !errors.Is(err, measurements.ErrUnknownUnit) {
+					t.Errorf("WriteBlock(%v) error = %v, want %v", p, err, measurements.ErrUnknownUnit)
+				}
+			}
+
+			// Prefixed units that are also built in keep their stable code.
+			p, err := measurements.ParsePressure("220 kPa")
+			if err != nil {
+				t.Fatalf("ParsePressure() error = %v", err)
+			}
+			data, err := measurements.PressureValue{Pressure: p}.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			var got measurements.PressureValue
+			if err := got.UnmarshalBinary(data); err != nil || got.String() != "220.00 kPa" {
+				t.Errorf("UnmarshalBinary() = %v, %v, want 220.00 kPa", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+// generate renders every file for s, keyed by file name. specName is
+// recorded in the generated header.
+func generate(s *Spec, specName string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	render := func(name string, tmpl *template.Template, data any) error {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		files[name] = src
+		return nil
+	}
+
+	if err := render("kind_gen.go", kindTemplate, struct {
+		*Spec
+		SpecName string
+	}{s, specName}); err != nil {
+		return nil, err
+	}
+	for _, q := range s.Quantities {
+		data := struct {
+			Quantity
+			Package  string
+			Import   string
+			SpecName string
+		}{q, s.Package, s.Import, specName}
+		file := strings.ToLower(q.Name)
+		if err := render(file+"_gen.go", quantityTemplate, data); err != nil {
+			return nil, err
+		}
+		if err := render(file+"_gen_test.go", testTemplate, data); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+const header = `// Code generated by measurementgen from {{.SpecName}}. DO NOT EDIT.
+
+`
+
+var kindTemplate = template.Must(template.New("kind").Parse(header + `package {{.Package}}
+
+// Kind identifies the physical quantity a unit measures.
+type Kind int32
+
+const (
+{{- range $i, $q := .Quantities}}
+	{{$q.Kind}}{{if eq $i 0}} Kind = iota{{end}}
+{{- end}}
+)
+
+var KindName = map[Kind]string{
+{{- range .Quantities}}
+	{{.Kind}}: {{printf "%q" .KindName}},
+{{- end}}
+}
+
+var kindConstructor = map[Kind]string{
+{{- range .Quantities}}
+	{{.Kind}}: "New{{.Name}}",
+{{- end}}
+}
+
+var kinds = []Kind{ {{- range $i, $q := .Quantities}}{{if $i}}, {{end}}{{$q.Kind}}{{end -}} }
+
+var unitRegistry = map[Kind][]UnitInfo{
+{{- range .Quantities}}
+	{{.Kind}}: {{.Lower}}Units,
+{{- end}}
+}
+
+// kindLimits holds the smallest physically possible value of each kind in
+// its base unit.
+var kindLimits = map[Kind]limit{
+{{- range .Quantities}}{{if .Minimum}}
+	{{.Kind}}: {min: {{.Minimum}}, err: {{.MinimumError}}},
+{{- end}}{{end}}
+}
+
+// unitCode returns the enum value the binary and stream encodings store
+// for unit, a unit of kind. Only built-in units have a code: units
+// registered at run time, prefixed ones included, are numbered in the order
+// they are added, which differs from one process to the next.
+func unitCode(kind Kind, unit Unit) (int32, bool) {
+	if unit == nil || unit.Kind() != kind {
+		return 0, false
+	}
+	switch unit := unit.(type) {
+{{- range .Quantities}}
+	case {{.UnitType}}:
+		return int32(unit), unit >= 0 && int(unit) < len({{.Lower}}Units)
+{{- end}}
+	}
+	return 0, false
+}
+
+// unitFromCode is the inverse of unitCode.
+func unitFromCode(kind Kind, code int32) (Unit, bool) {
+	unit := makeUnit(kind, code)
+	if unit == nil {
+		return nil, false
+	}
+	_, ok := unitCode(kind, unit)
+	return unit, ok
+}
+
+// makeUnit returns the unit of kind with enum value code, whether or not it
+// is registered.
+func makeUnit(kind Kind, code int32) Unit {
+	switch kind {
+{{- range .Quantities}}
+	case {{.Kind}}:
+		return {{.UnitType}}(code)
+{{- end}}
+	}
+	return nil
+}
+
+func newMeasurement(kind Kind, unit Unit, value float64) Measurement {
+	switch kind {
+{{- range .Quantities}}
+	case {{.Kind}}:
+		return New{{.Name}}(unit.({{.UnitType}}), value)
+{{- end}}
+	}
+	return nil
+}
+`))
+
+var quantityTemplate = template.Must(template.New("quantity").Parse(header + `package {{.Package}}
+
+type {{.UnitType}} int32
+
+const (
+{{- range $i, $u := .Units}}
+	{{$u.Name}}{{if eq $i 0}} {{$.UnitType}} = iota{{end}}
+{{- end}}
+)
+
+// Invalid{{.UnitType}} is returned by Register{{.UnitType}} on error. It is
+// never a {{.KindName}} unit, so IsValid reports false and ConvertTo and the
+// encodings reject it.
+const Invalid{{.UnitType}} {{.UnitType}} = -1
+
+// {{.NameMap}} and {{.ValueMap}} hold the built-in {{.KindName}} units only; units
+// added by Register{{.UnitType}} are found through LookupUnit.
+var {{.NameMap}} = map[{{.UnitType}}]string{
+{{- range .Units}}
+	{{.Name}}: {{printf "%q" .String}},
+{{- end}}
+}
+
+var {{.ValueMap}} = map[string]{{.UnitType}}{
+{{- range .Units}}
+	{{printf "%q" .String}}: {{.Name}},
+{{- end}}
+}
+
+{{- with .References}}
+// {{$.Lower}}References holds the built-in {{$.KindName}} units that are not
+// measured from a vacuum.
+var {{$.Lower}}References = map[{{$.UnitType}}]{{$.Name}}Reference{
+{{- range .}}
+	{{.Name}}: {{.Reference}},
+{{- end}}
+}
+{{end}}
+var {{.Lower}}Units = []UnitInfo{
+{{- range .Units}}
+	{Unit: {{.Name}}, Kind: {{$.Kind}}, Symbol: {{printf "%q" .Symbol}}, Singular: {{printf "%q" .Singular}}, Plural: {{printf "%q" .Plural}}
+		{{- if .System}}, System: {{.System}}{{end}}
+		{{- if .Base}}, Base: true{{end}}{{if .Prefixable}}, Prefixable: true{{end}}, Factor: {{.Factor}}
+		{{- if .Offset}}, Offset: {{.Offset}}{{end}}, name: {{printf "%q" .String}}
+		{{- if .Aliases}}, aliases: {{printf "%#v" .Aliases}}{{end}}, goName: {{printf "%q" .Name}}},
+{{- end}}
+}
+
+func (s {{.UnitType}}) Kind() Kind {
+	return {{.Kind}}
+}
+
+func (s {{.UnitType}}) String() string {
+	return unitInfo(s).name
+}
+
+func (s {{.UnitType}}) Symbol() string {
+	return s.Info().Symbol
+}
+
+func (s {{.UnitType}}) Singular() string {
+	return s.Info().Singular
+}
+
+func (s {{.UnitType}}) Plural() string {
+	return s.Info().Plural
+}
+
+func (s {{.UnitType}}) Info() UnitInfo {
+	return unitInfo(s)
+}
+
+func (s {{.UnitType}}) GoString() string {
+	return unitGoString(s, "{{.UnitType}}", int32(s))
+}
+
+// IsValid reports whether s is a built-in or registered {{.KindName}} unit.
+func (s {{.UnitType}}) IsValid() bool {
+	_, ok := lookupUnitInfo({{.Kind}}, s)
+	return ok
+}
+
+type {{.Name}} interface {
+	Measurement
+
+	Unit() {{.UnitType}}
+	Value() float64
+	String() string
+
+	Validate() error
+
+	To(unit {{.UnitType}}) {{.Name}}
+	ConvertTo(unit {{.UnitType}}) ({{.Name}}, error)
+{{- range .Units}}
+	To{{.Method}}() {{$.Name}}
+{{- end}}
+}
+
+type {{.Lower}} struct {
+	Quantity[{{.UnitType}}]
+}
+
+// Register{{.UnitType}} adds a {{.KindName}} unit at run time. The unit works with
+// To, parsing and formatting like the built-in units, but its enum value
+// depends on the order of registration, so it cannot be written by
+// MarshalBinary or an Encoder. On error it returns Invalid{{.UnitType}}.
+func Register{{.UnitType}}(def UnitDefinition) ({{.UnitType}}, error) {
+	info, err := registerUnit({{.Kind}}, def)
+	if err != nil {
+		return Invalid{{.UnitType}}, err
+	}
+	return info.Unit.({{.UnitType}}), nil
+}
+
+func New{{.Name}}(unit {{.UnitType}}, value float64) {{.Name}} {
+	return &{{.Lower}}{NewQuantity(unit, value)}
+}
+
+// New{{.Name}}Checked is New{{.Name}} for untrusted values: it returns a
+// *ValueError when value is NaN, infinite or physically impossible.
+func New{{.Name}}Checked(unit {{.UnitType}}, value float64) ({{.Name}}, error) {
+	m := New{{.Name}}(unit, value)
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+{{range .Units}}
+func From{{.Method}}(value float64) {{$.Name}} {
+	return New{{$.Name}}({{.Name}}, value)
+}
+{{end}}
+func Parse{{.Name}}(s string) ({{.Name}}, error) {
+	value, info, err := parseMeasurement({{.Kind}}, s)
+	if err != nil {
+		return nil, err
+	}
+	return New{{.Name}}(info.Unit.({{.UnitType}}), value), nil
+}
+
+// Best{{.UnitType}} returns the unit that shows m most readably, as chosen
+// by BestUnit.
+func Best{{.UnitType}}(m {{.Name}}, opts HumanizeOptions) {{.UnitType}} {
+	return BestUnit(m, opts).({{.UnitType}})
+}
+
+func (s *{{.Lower}}) To(unit {{.UnitType}}) {{.Name}} {
+	return &{{.Lower}}{s.Quantity.To(unit)}
+}
+
+func (s *{{.Lower}}) convertedTo(unit Unit, value float64) Measurement {
+	return New{{.Name}}(unit.({{.UnitType}}), value)
+}
+
+func (s *{{.Lower}}) ConvertTo(unit {{.UnitType}}) ({{.Name}}, error) {
+	q, err := s.Quantity.ConvertTo(unit)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Lower}}{q}, nil
+}
+{{range .Units}}
+func (s *{{$.Lower}}) To{{.Method}}() {{$.Name}} {
+	return s.To({{.Name}})
+}
+{{end}}`))
+
+var testTemplate = template.Must(template.New("test").Parse(header + `package {{.Package}}_test
+
+import (
+	"reflect"
+	"testing"
+
+	{{printf "%q" .Import}}
+)
+{{$base := .BaseUnit}}
+func Test_{{.Lower}}_generated(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.{{.UnitType}}
+		// base is one of unit in {{$base.Plural}}.
+		base float64
+	}{
+{{- range .Units}}{{if ne .Reference "Differential"}}
+		{
+			name: {{printf "%q" .Name}},
+			unit: measurements.{{.Name}},
+			base: {{.BaseValue}},
+		},
+{{- end}}{{end}}
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			one := measurements.New{{.Name}}(tt.unit, 1)
+			if got, want := one.To{{$base.Method}}(), measurements.From{{$base.Method}}(tt.base); !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("To{{$base.Method}}() = %v, want %v", got, want)
+			}
+			if got := one.To{{$base.Method}}().To(tt.unit); !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("To{{$base.Method}}().To() = %v, want %v", got, one)
+			}
+			got, err := measurements.Parse{{.Name}}(one.String())
+			if err != nil {
+				t.Fatalf("Parse{{.Name}}() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("Parse{{.Name}}() = %v, want %v", got, one)
+			}
+		})
+	}
+}
+`))
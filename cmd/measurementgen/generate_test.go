@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_generate_upToDate(t *testing.T) {
+	spec, err := readSpec("../../quantities.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(spec, "quantities.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join("../..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
+
+func Test_Spec_validate(t *testing.T) {
+	unit := func(name, symbol string, base bool) Unit {
+		return Unit{Name: name, String: symbol, Base: base, Factor: "1"}
+	}
+	quantity := func(units ...Unit) Spec {
+		return Spec{
+			Package: "measurements",
+			Import:  "example.com/measurements",
+			Quantities: []Quantity{
+				{Name: "Mass", Kind: "MassKind", UnitType: "MassUnit", NameMap: "MassUnitName", ValueMap: "MassUnitValue", Units: units},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		spec    Spec
+		wantErr string
+	}{
+		{
+			name: "Valid",
+			spec: quantity(unit("Kilogram", "kg", true), unit("Gram", "g", false)),
+		},
+		{
+			name:    "Unknown reference",
+			spec:    quantity(unit("Kilogram", "kg", true), Unit{Name: "Gram", String: "g", Factor: "0.001", Reference: "Sealed"}),
+			wantErr: "unknown reference",
+		},
+		{
+			name:    "No base unit",
+			spec:    quantity(unit("Gram", "g", false)),
+			wantErr: "0 base units",
+		},
+		{
+			name:    "Two base units",
+			spec:    quantity(unit("Kilogram", "kg", true), unit("Gram", "g", true)),
+			wantErr: "2 base units",
+		},
+		{
+			name:    "Duplicate unit",
+			spec:    quantity(unit("Kilogram", "kg", true), unit("Kilogram", "kilo", false)),
+			wantErr: "duplicate unit Kilogram",
+		},
+		{
+			name:    "Duplicate symbol",
+			spec:    quantity(unit("Kilogram", "kg", true), unit("Kilo", "kg", false)),
+			wantErr: "duplicate symbol",
+		},
+		{
+			name:    "Missing factor",
+			spec:    quantity(Unit{Name: "Kilogram", String: "kg", Base: true}),
+			wantErr: "missing name, string or factor",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
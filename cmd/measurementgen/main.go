@@ -0,0 +1,49 @@
+// Command measurementgen generates the quantity types of package measurements
+// from a JSON spec of quantities and their units.
+//
+// For every quantity it writes <quantity>_gen.go, holding the unit enum, its
+// name maps and registry table, the quantity interface, constructors and
+// conversions, and <quantity>_gen_test.go with a table-driven test of every
+// unit. kind_gen.go ties the quantities together into the Kind enum and the
+// unit registry. It is run from the package directory by go generate:
+//
+//	//go:generate go run ./cmd/measurementgen -spec quantities.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("measurementgen: ")
+
+	spec := flag.String("spec", "quantities.json", "JSON spec of the quantities to generate")
+	out := flag.String("out", ".", "directory to write the generated files to")
+	flag.Parse()
+
+	s, err := readSpec(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(s, filepath.Base(*spec))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(*out, name), files[name], 0o644); err != nil {
+			log.Fatal(fmt.Errorf("writing %s: %w", name, err))
+		}
+	}
+}
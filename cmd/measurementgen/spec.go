@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Spec lists the quantities of a package in the order of their Kind.
+type Spec struct {
+	Package string `json:"package"`
+	// Import is the import path of the package, used by the generated tests.
+	Import     string     `json:"import"`
+	Quantities []Quantity `json:"quantities"`
+}
+
+// Quantity describes a quantity type, such as Mass, and the names of the
+// declarations generated for it.
+type Quantity struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	KindName string `json:"kindName"`
+	UnitType string `json:"unitType"`
+	NameMap  string `json:"nameMap"`
+	ValueMap string `json:"valueMap"`
+	// Minimum is a Go expression for the smallest physically possible value
+	// in the base unit, if any. Values below it fail validation with
+	// MinimumError, which defaults to ErrNegative.
+	Minimum      string `json:"minimum"`
+	MinimumError string `json:"minimumError"`
+	Units        []Unit `json:"units"`
+}
+
+// Unit describes one unit of a quantity. Factor and Offset are Go constant
+// expressions converting a value in the unit to the base unit:
+// base = value*Factor + Offset.
+type Unit struct {
+	Name string `json:"name"`
+	// Method names the FromX and ToX functions, defaulting to Name.
+	Method string `json:"method"`
+	String string `json:"string"`
+	// Symbol defaults to String.
+	Symbol   string `json:"symbol"`
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+	// System is a Go expression of the unit's systems, such as
+	// "USCustomary | Imperial".
+	System string `json:"system"`
+	Base   bool   `json:"base"`
+	// Prefixable units accept SI prefixes, such as the gram in milligram.
+	Prefixable bool `json:"prefixable"`
+	// Aliases are further symbols the unit is parsed from.
+	Aliases []string `json:"aliases"`
+	Factor  string   `json:"factor"`
+	Offset  string   `json:"offset"`
+	// Reference is the zero point of a pressure unit other than a vacuum:
+	// "Gauge" or "Differential". Differential units do not convert to
+	// units of other references.
+	Reference string `json:"reference"`
+}
+
+func readSpec(name string) (*Spec, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var s Spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &s, nil
+}
+
+// validate fills in defaults and checks that every quantity has a single
+// base unit and no two units share a name or symbol.
+func (s *Spec) validate() error {
+	if s.Package == "" || s.Import == "" {
+		return fmt.Errorf("missing package or import")
+	}
+	if len(s.Quantities) == 0 {
+		return fmt.Errorf("no quantities")
+	}
+
+	names := map[string]bool{}
+	for i := range s.Quantities {
+		q := &s.Quantities[i]
+		if q.Name == "" || q.Kind == "" || q.UnitType == "" || q.NameMap == "" || q.ValueMap == "" {
+			return fmt.Errorf("quantity %d: missing name, kind, unitType, nameMap or valueMap", i)
+		}
+		if q.KindName == "" {
+			q.KindName = strings.ToLower(q.Name)
+		}
+		if q.Minimum != "" && q.MinimumError == "" {
+			q.MinimumError = "ErrNegative"
+		}
+		if len(q.Units) == 0 {
+			return fmt.Errorf("%s: no units", q.Name)
+		}
+
+		bases := 0
+		symbols := map[string]bool{}
+		for j := range q.Units {
+			u := &q.Units[j]
+			if u.Name == "" || u.String == "" || u.Factor == "" {
+				return fmt.Errorf("%s unit %d: missing name, string or factor", q.Name, j)
+			}
+			if u.Method == "" {
+				u.Method = u.Name
+			}
+			if u.Symbol == "" {
+				u.Symbol = u.String
+			}
+			if names[u.Name] {
+				return fmt.Errorf("%s: duplicate unit %s", q.Name, u.Name)
+			}
+			names[u.Name] = true
+			if u.Reference != "" && u.Reference != "Gauge" && u.Reference != "Differential" {
+				return fmt.Errorf("%s: unit %s has unknown reference %q", q.Name, u.Name, u.Reference)
+			}
+			if u.Prefixable && u.Offset != "" {
+				return fmt.Errorf("%s: prefixable unit %s has an offset", q.Name, u.Name)
+			}
+			own := map[string]bool{}
+			for _, symbol := range append([]string{u.String, u.Symbol}, u.Aliases...) {
+				if own[symbol] {
+					continue
+				}
+				if symbols[symbol] {
+					return fmt.Errorf("%s: duplicate symbol %q", q.Name, symbol)
+				}
+				own[symbol] = true
+				symbols[symbol] = true
+			}
+			if u.Base {
+				bases++
+			}
+		}
+		if bases != 1 {
+			return fmt.Errorf("%s: %d base units, want 1", q.Name, bases)
+		}
+	}
+	return nil
+}
+
+// References lists the units with a Reference.
+func (s Quantity) References() []Unit {
+	var units []Unit
+	for _, u := range s.Units {
+		if u.Reference != "" {
+			units = append(units, u)
+		}
+	}
+	return units
+}
+
+// Lower is the name of the unexported struct implementing the quantity.
+func (s Quantity) Lower() string {
+	return strings.ToLower(s.Name[:1]) + s.Name[1:]
+}
+
+// BaseUnit returns the unit every other unit is defined against.
+func (s Quantity) BaseUnit() Unit {
+	for _, u := range s.Units {
+		if u.Base {
+			return u
+		}
+	}
+	return Unit{}
+}
+
+// BaseValue is a Go expression for one of the unit in the base unit.
+func (s Unit) BaseValue() string {
+	if s.Offset == "" {
+		return s.Factor
+	}
+	return s.Factor + " + " + s.Offset
+}
@@ -0,0 +1,108 @@
+package measurements
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Compound writes a value as a sum of units, largest first, such as the
+// pounds and ounces of "11 lb 6 oz". Its units must not have an offset.
+type Compound[U Unit] []U
+
+var (
+	PoundsAndOunces         = Compound[MassUnit]{Pound, Ounce}
+	StoneAndPounds          = Compound[MassUnit]{Stone, Pound}
+	GallonsAndQuarts        = Compound[VolumeType]{USLiquidGallon, USLiquidQuart}
+	ImperialGallonsAndPints = Compound[VolumeType]{ImperialGallon, ImperialPint}
+)
+
+// Split converts m into a whole number of each unit but the last, which
+// holds the remainder rounded to precision decimals. Every part carries
+// the sign of m. Split returns nil when m is not of the compound's kind.
+func (s Compound[U]) Split(m Measurement, precision int) []Quantity[U] {
+	if len(s) == 0 {
+		return nil
+	}
+	last := s[len(s)-1]
+	total, err := convertValue(last.Kind(), m.Value(), m.measurementUnit(), last)
+	if err != nil {
+		return nil
+	}
+
+	scale := math.Pow10(precision)
+	total = math.Round(total*scale) / scale
+	sign := 1.0
+	if total < 0 {
+		sign, total = -1, -total
+	}
+
+	parts := make([]Quantity[U], len(s))
+	for i, unit := range s[:len(s)-1] {
+		size := NewQuantity(unit, 1).To(last).Value()
+		// The tolerance stops rounding error turning 16 oz into 15.99...
+		whole := math.Floor(total/size + 1e-9)
+		total = math.Max(0, total-whole*size)
+		parts[i] = NewQuantity(unit, sign*whole)
+	}
+	parts[len(s)-1] = NewQuantity(last, sign*math.Round(total*scale)/scale)
+	return parts
+}
+
+// Join adds values, one for each unit of the compound, into a quantity in
+// its last unit, so PoundsAndOunces.Join(11, 6) is 182 oz.
+func (s Compound[U]) Join(values ...float64) (Quantity[U], error) {
+	if len(values) == 0 || len(values) > len(s) {
+		return Quantity[U]{}, fmt.Errorf("measurements: %d values for %d units", len(values), len(s))
+	}
+	last := s[len(s)-1]
+	total := 0.0
+	for i, value := range values {
+		total += NewQuantity(s[i], value).To(last).Value()
+	}
+	return NewQuantity(last, total), nil
+}
+
+// Format writes m in the units of the compound, such as "12 st 4 lb",
+// leaving out parts that are zero.
+func (s Compound[U]) Format(m Measurement, precision int) string {
+	parts := s.Split(m, precision)
+	if parts == nil {
+		return Format(m, DefaultFormatOptions)
+	}
+
+	var terms []string
+	for _, part := range parts {
+		if part.Value() != 0 {
+			terms = append(terms, formatCompoundPart(part, len(terms) == 0))
+		}
+	}
+	if len(terms) == 0 {
+		return formatCompoundPart(parts[len(parts)-1], true)
+	}
+	return strings.Join(terms, " ")
+}
+
+// formatCompoundPart writes the sign only on the first term.
+func formatCompoundPart[U Unit](part Quantity[U], first bool) string {
+	value := part.Value()
+	if !first {
+		value = math.Abs(value)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64) + " " + part.Unit().Symbol()
+}
+
+// Parse reads a value written in mixed units, such as "11 lb 6 oz", and
+// returns it in the last unit of the compound.
+func (s Compound[U]) Parse(text string) (Quantity[U], error) {
+	if len(s) == 0 {
+		return Quantity[U]{}, fmt.Errorf("%w: empty compound", ErrSyntax)
+	}
+	last := s[len(s)-1]
+	value, info, err := parseMeasurement(last.Kind(), text)
+	if err != nil {
+		return Quantity[U]{}, err
+	}
+	return NewQuantity(info.Unit.(U), value).To(last), nil
+}
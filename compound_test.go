@@ -0,0 +1,162 @@
+package measurements_test
+
+import (
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Compound_Format(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    func(measurements.Measurement, int) string
+		m         measurements.Measurement
+		precision int
+		want      string
+	}{
+		{
+			name:   "Pounds and ounces",
+			format: measurements.PoundsAndOunces.Format,
+			m:      measurements.FromOunce(182),
+			want:   "11 lb 6 oz",
+		},
+		{
+			name:   "Stone and pounds",
+			format: measurements.StoneAndPounds.Format,
+			m:      measurements.FromPound(172),
+			want:   "12 st 4 lb",
+		},
+		{
+			name:   "From kilograms",
+			format: measurements.StoneAndPounds.Format,
+			m:      measurements.FromKilogram(78.01789),
+			want:   "12 st 4 lb",
+		},
+		{
+			name:      "Fractional remainder",
+			format:    measurements.PoundsAndOunces.Format,
+			m:         measurements.FromPound(1.2),
+			precision: 1,
+			want:      "1 lb 3.2 oz",
+		},
+		{
+			name:   "Rounding carries",
+			format: measurements.PoundsAndOunces.Format,
+			m:      measurements.FromOunce(15.9),
+			want:   "1 lb",
+		},
+		{
+			name:   "Gallons and quarts",
+			format: measurements.GallonsAndQuarts.Format,
+			m:      measurements.FromUSLiquidQuart(6),
+			want:   "1 gal 2 qt",
+		},
+		{
+			name:   "Imperial gallons and pints",
+			format: measurements.ImperialGallonsAndPints.Format,
+			m:      measurements.FromLiter(5.11435125),
+			want:   "1 imp gal 1 imp pt",
+		},
+		{
+			name:   "Negative",
+			format: measurements.PoundsAndOunces.Format,
+			m:      measurements.FromOunce(-20),
+			want:   "-1 lb 4 oz",
+		},
+		{
+			name:   "Zero",
+			format: measurements.PoundsAndOunces.Format,
+			m:      measurements.FromKilogram(0),
+			want:   "0 oz",
+		},
+		{
+			name:   "Other kind",
+			format: measurements.PoundsAndOunces.Format,
+			m:      measurements.FromLiter(1),
+			want:   "1.00 l",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format(tt.m, tt.precision); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Compound_Join(t *testing.T) {
+	got, err := measurements.StoneAndPounds.Join(12, 4)
+	if err != nil {
+		t.Fatalf("Join() error = %v", err)
+	}
+	if want := "172.00 lb"; got.String() != want {
+		t.Errorf("Join() = %v, want %v", got, want)
+	}
+	if _, err := measurements.StoneAndPounds.Join(1, 2, 3); err == nil {
+		t.Error("Join() error = nil")
+	}
+}
+
+func Test_Compound_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "Pounds and ounces", input: "11 lb 6 oz", want: "182.00 oz"},
+		{name: "No spaces", input: "11lb6oz", want: "182.00 oz"},
+		{name: "Names", input: "1 pound 3 ounces", want: "19.00 oz"},
+		{name: "Single unit", input: "2 lb", want: "32.00 oz"},
+		{name: "Negative", input: "-1 lb 4 oz", want: "-20.00 oz"},
+		{name: "Unknown unit", input: "11 lb 6 furlongs", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.PoundsAndOunces.Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParseMixedUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    measurements.Kind
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "Stone and pounds", kind: measurements.MassKind, input: "12 st 4 lb", want: "172.00 lb"},
+		{name: "Gallons and quarts", kind: measurements.VolumeKind, input: "1 gal 2 qt", want: "6.00 qt"},
+		{name: "Mixed temperature", kind: measurements.TemperatureKind, input: "1 °C 2 K", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got measurements.Measurement
+				err error
+			)
+			switch tt.kind {
+			case measurements.MassKind:
+				got, err = measurements.ParseMass(tt.input)
+			case measurements.VolumeKind:
+				got, err = measurements.ParseVolume(tt.input)
+			case measurements.TemperatureKind:
+				got, err = measurements.ParseTemperature(tt.input)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package measurements
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Column is a CSV column whose header may carry a unit annotation, such as
+// "weight (lb)" or "pressure [Pa]". Unit is nil for columns without one.
+type Column struct {
+	Name string
+	Kind Kind
+	Unit Unit
+	// Square writes the unit annotation in square rather than round brackets.
+	Square bool
+}
+
+// ParseColumn reads a column header. Headers whose annotation is not a known
+// unit are kept whole as the column name.
+func ParseColumn(header string) Column {
+	header = strings.TrimSpace(header)
+	for _, brackets := range []string{"()", "[]"} {
+		if !strings.HasSuffix(header, brackets[1:]) {
+			continue
+		}
+		open := strings.LastIndex(header, brackets[:1])
+		if open < 0 {
+			continue
+		}
+		symbol := strings.TrimSpace(header[open+1 : len(header)-1])
+		for _, kind := range kinds {
+			if info, ok := LookupUnit(kind, symbol); ok {
+				return Column{
+					Name:   strings.TrimSpace(header[:open]),
+					Kind:   kind,
+					Unit:   info.Unit,
+					Square: brackets == "[]",
+				}
+			}
+		}
+	}
+	return Column{Name: header}
+}
+
+// Header writes the column name with its unit annotation.
+func (s Column) Header() string {
+	if s.Unit == nil {
+		return s.Name
+	}
+	if s.Square {
+		return fmt.Sprintf("%s [%s]", s.Name, s.Unit.Symbol())
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, s.Unit.Symbol())
+}
+
+// InSystem returns the column with its unit replaced by the unit of system
+// closest in size to the current one. Columns without a unit, or already in
+// system, are returned unchanged.
+func (s Column) InSystem(system System) Column {
+	if s.Unit == nil || s.Unit.Info().System.Has(system) {
+		return s
+	}
+	if info, ok := closestUnit(s.Kind, s.Unit.Info(), system); ok {
+		s.Unit = info.Unit
+	}
+	return s
+}
+
+// Record is a CSV row. Measurements holds the decoded cell of every column
+// with a unit, and nil for other columns and empty cells.
+type Record struct {
+	Fields       []string
+	Measurements []Measurement
+}
+
+// CSVReader decodes CSV files whose headers carry unit annotations.
+type CSVReader struct {
+	r       *csv.Reader
+	columns []Column
+	line    int
+}
+
+func NewCSVReader(r io.Reader) *CSVReader {
+	return &CSVReader{r: csv.NewReader(r)}
+}
+
+// Columns reads the header row, if it has not been read yet, and returns
+// the columns it describes.
+func (s *CSVReader) Columns() ([]Column, error) {
+	if s.columns != nil {
+		return s.columns, nil
+	}
+
+	headers, err := s.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	s.line++
+	s.columns = make([]Column, len(headers))
+	for i, header := range headers {
+		s.columns[i] = ParseColumn(header)
+	}
+	return s.columns, nil
+}
+
+// Read returns the next record. Cells of a unit column hold either a bare
+// number in the column's unit or a value with its own unit, such as "3 oz".
+func (s *CSVReader) Read() (Record, error) {
+	columns, err := s.Columns()
+	if err != nil {
+		return Record{}, err
+	}
+
+	fields, err := s.r.Read()
+	if err != nil {
+		return Record{}, err
+	}
+	s.line++
+
+	record := Record{Fields: fields, Measurements: make([]Measurement, len(fields))}
+	for i, field := range fields {
+		if i >= len(columns) || columns[i].Unit == nil || strings.TrimSpace(field) == "" {
+			continue
+		}
+		m, err := parseCell(columns[i], field)
+		if err != nil {
+			return Record{}, fmt.Errorf("measurements: record %d, column %q: %w", s.line, columns[i].Name, err)
+		}
+		record.Measurements[i] = m
+	}
+	return record, nil
+}
+
+func parseCell(column Column, field string) (Measurement, error) {
+	field = strings.TrimSpace(field)
+	if value, err := strconv.ParseFloat(field, 64); err == nil {
+		return newMeasurement(column.Kind, column.Unit, value), nil
+	}
+	value, info, err := parseMeasurement(column.Kind, field)
+	if err != nil {
+		return nil, err
+	}
+	return newMeasurement(column.Kind, info.Unit, value), nil
+}
+
+// CSVWriter encodes records, converting every measurement to the unit of
+// its column.
+type CSVWriter struct {
+	w       *csv.Writer
+	columns []Column
+}
+
+func NewCSVWriter(w io.Writer, columns []Column) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), columns: columns}
+}
+
+// WriteHeader writes the header row for the writer's columns.
+func (s *CSVWriter) WriteHeader() error {
+	headers := make([]string, len(s.columns))
+	for i, column := range s.columns {
+		headers[i] = column.Header()
+	}
+	return s.w.Write(headers)
+}
+
+// Write writes record. Cells with a measurement are written as a bare number
+// in their column's unit; other cells are copied from Fields.
+func (s *CSVWriter) Write(record Record) error {
+	n := len(record.Fields)
+	if len(record.Measurements) > n {
+		n = len(record.Measurements)
+	}
+	fields := make([]string, n)
+	copy(fields, record.Fields)
+
+	for i, m := range record.Measurements {
+		if m == nil || i >= len(s.columns) || s.columns[i].Unit == nil {
+			continue
+		}
+		value, err := convertValue(s.columns[i].Kind, m.Value(), m.measurementUnit(), s.columns[i].Unit)
+		if err != nil {
+			return err
+		}
+		// Twelve significant figures hides the rounding error of the conversion.
+		fields[i] = strconv.FormatFloat(value, 'g', 12, 64)
+	}
+	return s.w.Write(fields)
+}
+
+// Flush writes any buffered data and reports any error from earlier writes.
+func (s *CSVWriter) Flush() error {
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// ConvertCSV copies a CSV file from src to dst with every unit column
+// converted to system.
+func ConvertCSV(dst io.Writer, src io.Reader, system System) error {
+	r := NewCSVReader(src)
+	columns, err := r.Columns()
+	if err != nil {
+		return err
+	}
+
+	converted := make([]Column, len(columns))
+	for i, column := range columns {
+		converted[i] = column.InSystem(system)
+	}
+
+	w := NewCSVWriter(dst, converted)
+	if err := w.WriteHeader(); err != nil {
+		return err
+	}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,150 @@
+package measurements_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_ParseColumn(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   measurements.Column
+	}{
+		{
+			name:   "Round brackets",
+			header: "weight (lb)",
+			want:   measurements.Column{Name: "weight", Kind: measurements.MassKind, Unit: measurements.Pound},
+		},
+		{
+			name:   "Square brackets",
+			header: "pressure [Pa]",
+			want:   measurements.Column{Name: "pressure", Kind: measurements.PressureKind, Unit: measurements.Pascal, Square: true},
+		},
+		{
+			name:   "Multi-word symbol",
+			header: " volume (imp fl oz) ",
+			want:   measurements.Column{Name: "volume", Kind: measurements.VolumeKind, Unit: measurements.ImperialFluidOunce},
+		},
+		{
+			name:   "Degree symbol",
+			header: "temperature (°F)",
+			want:   measurements.Column{Name: "temperature", Kind: measurements.TemperatureKind, Unit: measurements.Fahrenheit},
+		},
+		{
+			name:   "Unknown annotation",
+			header: "notes (free text)",
+			want:   measurements.Column{Name: "notes (free text)"},
+		},
+		{
+			name:   "No annotation",
+			header: "id",
+			want:   measurements.Column{Name: "id"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.ParseColumn(tt.header); got != tt.want {
+				t.Errorf("ParseColumn() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_CSVReader(t *testing.T) {
+	input := "id,weight (lb),temperature [°C]\n" +
+		"a,12.5,21\n" +
+		"b,3 oz,\n"
+
+	r := measurements.NewCSVReader(strings.NewReader(input))
+	want := [][]string{
+		{"", "12.50 lb", "21.00 °C"},
+		{"", "3.00 oz", ""},
+	}
+	for i, row := range want {
+		record, err := r.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, cell := range row {
+			got := ""
+			if m := record.Measurements[j]; m != nil {
+				got = m.String()
+			}
+			if got != cell {
+				t.Errorf("record %d column %d = %q, want %q", i, j, got, cell)
+			}
+		}
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read() at end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func Test_CSVReader_BadCell(t *testing.T) {
+	r := measurements.NewCSVReader(strings.NewReader("weight (kg)\nheavy\n"))
+	if _, err := r.Read(); err == nil || !strings.Contains(err.Error(), `column "weight"`) {
+		t.Errorf("Read() error = %v, want an error naming the column", err)
+	}
+}
+
+func Test_ConvertCSV(t *testing.T) {
+	tests := []struct {
+		name   string
+		system measurements.System
+		input  string
+		want   string
+	}{
+		{
+			name:   "To SI",
+			system: measurements.SI,
+			input:  "id,weight (lb),dose (oz),pressure [psi],volume (gal),temperature (°F)\nx,2,1,14.5,1,212\n",
+			want:   "id,weight (kg),dose (g),pressure [kPa],volume (l),temperature (°C)\nx,0.90718474,28.349523125,99.9739807509,3.785411784,100\n",
+		},
+		{
+			name:   "To imperial",
+			system: measurements.Imperial,
+			input:  "volume (l),beer (ml),note\n4.54609,568.26125,keep\n",
+			want:   "volume (imp qt),beer (imp fl oz),note\n4,20,keep\n",
+		},
+		{
+			name:   "Negative factor",
+			system: measurements.SI,
+			input:  "oven [°De],cellar [°Ré]\n-90,8\n",
+			want:   "oven [°C],cellar [°C]\n160,10\n",
+		},
+		{
+			name:   "Gauge column",
+			system: measurements.SI,
+			input:  "tyre [psig]\n30\n",
+			want:   "tyre [barg]\n2.06842718795\n",
+		},
+		{
+			name:   "Differential column",
+			system: measurements.SI,
+			input:  "filter [psid]\n5\n",
+			want:   "filter [psid]\n5\n",
+		},
+		{
+			name:   "Already in system",
+			system: measurements.SI,
+			input:  "weight (kg)\n1.5\n",
+			want:   "weight (kg)\n1.5\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := measurements.ConvertCSV(&buf, strings.NewReader(tt.input), tt.system); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("ConvertCSV() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
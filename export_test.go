@@ -0,0 +1,31 @@
+package measurements
+
+import "testing"
+
+// KeepUnits removes the units registered during the test when it ends, so
+// tests can register units without affecting each other.
+func KeepUnits(t testing.TB) {
+	registryMu.RLock()
+	saved := map[Kind][]UnitInfo{}
+	for kind, units := range unitRegistry {
+		saved[kind] = units
+	}
+	registryMu.RUnlock()
+
+	t.Cleanup(func() {
+		registryMu.Lock()
+		defer registryMu.Unlock()
+		for kind, units := range saved {
+			unitRegistry[kind] = units
+		}
+	})
+}
+
+// BuiltinUnits holds the built-in units of each kind, without those
+// registered at run time.
+var BuiltinUnits = map[Kind][]UnitInfo{
+	MassKind:        massUnits,
+	PressureKind:    pressureUnits,
+	VolumeKind:      volumeUnits,
+	TemperatureKind: temperatureUnits,
+}
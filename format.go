@@ -0,0 +1,144 @@
+package measurements
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Measurement is the behaviour shared by Mass, Pressure, Volume and Temperature.
+type Measurement interface {
+	Value() float64
+	String() string
+
+	measurementUnit() Unit
+}
+
+// FormatOptions controls how Format renders a measurement.
+type FormatOptions struct {
+	// Precision is the number of digits after the decimal point, or -1 for
+	// the fewest digits needed to represent the value exactly.
+	Precision int
+	// SignificantFigures rounds the value to that many significant figures
+	// when positive and takes precedence over Precision.
+	SignificantFigures int
+	// Name writes the unit's long name ("kilograms") instead of its symbol.
+	Name bool
+	// NoSpace omits the space between the value and the unit.
+	NoSpace bool
+	// Locale sets the decimal and grouping separators and translates unit
+	// names. A nil Locale writes plain Go numbers and English names.
+	Locale *Locale
+}
+
+// DefaultFormatOptions matches the output of String.
+var DefaultFormatOptions = FormatOptions{Precision: 2}
+
+// Format renders m according to opts.
+func Format(m Measurement, opts FormatOptions) string {
+	return formatMeasurement(m.Value(), m.measurementUnit(), opts)
+}
+
+func formatMeasurement(value float64, unit Unit, opts FormatOptions) string {
+	number := opts.Locale.formatNumber(formatNumber(value, opts))
+
+	label := unit.Symbol()
+	if opts.Name {
+		label = opts.Locale.unitName(value, unit)
+	}
+
+	if opts.NoSpace {
+		return number + label
+	}
+	return number + " " + label
+}
+
+func formatNumber(value float64, opts FormatOptions) string {
+	if opts.SignificantFigures <= 0 {
+		return strconv.FormatFloat(value, 'f', opts.Precision, 64)
+	}
+
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', opts.SignificantFigures-1, 64)
+	}
+
+	rounded, decimals := roundSignificant(value, opts.SignificantFigures)
+	return strconv.FormatFloat(rounded, 'f', max(decimals, 0), 64)
+}
+
+// roundSignificant rounds value to figures significant figures and returns
+// the number of decimals they need, negative when they end left of the
+// point. The decimals come from the rounded value, so a carry such as 9.99
+// to 10 does not add a figure.
+func roundSignificant(value float64, figures int) (float64, int) {
+	if value == 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return value, figures - 1
+	}
+	s := strconv.FormatFloat(value, 'e', figures-1, 64)
+	exponent, _ := strconv.Atoi(s[strings.IndexByte(s, 'e')+1:])
+	rounded, _ := strconv.ParseFloat(s, 64)
+	return rounded, figures - 1 - exponent
+}
+
+func unitName(value float64, unit Unit) string {
+	if math.Abs(value) == 1 {
+		return unit.Singular()
+	}
+	return unit.Plural()
+}
+
+// formatState implements fmt.Formatter for every measurement type.
+//
+//	%v, %s     the value and unit symbol, as String ("%.4v" sets the precision)
+//	%+v        the value and long unit name
+//	%#v        a Go expression that constructs the measurement
+//	%e %f %g   the value formatted with that verb, followed by the unit symbol
+//
+// Width pads the whole measurement rather than just the number.
+func formatState(f fmt.State, verb rune, value float64, unit Unit, constructor string) {
+	var s string
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			s = fmt.Sprintf("measurements.%s(%#v, %#v)", constructor, unit, value)
+			break
+		}
+		opts := DefaultFormatOptions
+		if precision, ok := f.Precision(); ok {
+			opts.Precision = precision
+		}
+		opts.Name = verb == 'v' && f.Flag('+')
+		s = formatMeasurement(value, unit, opts)
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		s = fmt.Sprintf(numberDirective(f, verb), value) + " " + unit.Symbol()
+	default:
+		s = fmt.Sprintf("%%!%c(%s)", verb, formatMeasurement(value, unit, DefaultFormatOptions))
+	}
+
+	if width, ok := f.Width(); ok && width > len([]rune(s)) {
+		padding := strings.Repeat(" ", width-len([]rune(s)))
+		if f.Flag('-') {
+			s += padding
+		} else {
+			s = padding + s
+		}
+	}
+	fmt.Fprint(f, s)
+}
+
+func numberDirective(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+ #" {
+		if f.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if precision, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(precision))
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
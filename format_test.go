@@ -0,0 +1,174 @@
+package measurements_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Format(t *testing.T) {
+	tests := []struct {
+		name        string
+		measurement measurements.Measurement
+		opts        measurements.FormatOptions
+		want        string
+	}{
+		{
+			name:        "Default options",
+			measurement: measurements.FromKilogram(1234.5),
+			opts:        measurements.DefaultFormatOptions,
+			want:        "1234.50 kg",
+		},
+		{
+			name:        "Precision",
+			measurement: measurements.FromGram(0.0125),
+			opts:        measurements.FormatOptions{Precision: 4},
+			want:        "0.0125 g",
+		},
+		{
+			name:        "Shortest precision",
+			measurement: measurements.FromGram(0.0125),
+			opts:        measurements.FormatOptions{Precision: -1},
+			want:        "0.0125 g",
+		},
+		{
+			name:        "Significant figures",
+			measurement: measurements.FromPascal(101325),
+			opts:        measurements.FormatOptions{SignificantFigures: 3},
+			want:        "101000 Pa",
+		},
+		{
+			name:        "Significant figures below one",
+			measurement: measurements.FromBar(0.0012345),
+			opts:        measurements.FormatOptions{SignificantFigures: 2},
+			want:        "0.0012 bar",
+		},
+		{
+			name:        "Significant figures carry",
+			measurement: measurements.FromGram(9.99),
+			opts:        measurements.FormatOptions{SignificantFigures: 2},
+			want:        "10 g",
+		},
+		{
+			name:        "Significant figures carry below one",
+			measurement: measurements.FromBar(0.000999),
+			opts:        measurements.FormatOptions{SignificantFigures: 2},
+			want:        "0.0010 bar",
+		},
+		{
+			name:        "Plural name",
+			measurement: measurements.FromKilogram(2),
+			opts:        measurements.FormatOptions{Precision: 0, Name: true},
+			want:        "2 kilograms",
+		},
+		{
+			name:        "Singular name",
+			measurement: measurements.FromLiter(1),
+			opts:        measurements.FormatOptions{Precision: 0, Name: true},
+			want:        "1 litre",
+		},
+		{
+			name:        "Temperature name",
+			measurement: measurements.FromCelsius(21.5),
+			opts:        measurements.FormatOptions{Precision: 1, Name: true},
+			want:        "21.5 degrees Celsius",
+		},
+		{
+			name:        "No space",
+			measurement: measurements.FromCelsius(21.5),
+			opts:        measurements.FormatOptions{Precision: 1, NoSpace: true},
+			want:        "21.5°C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.Format(tt.measurement, tt.opts); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Formatter(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		measurement measurements.Measurement
+		want        string
+	}{
+		{
+			name:        "Value",
+			format:      "%v",
+			measurement: measurements.FromGram(0.0125),
+			want:        "0.01 g",
+		},
+		{
+			name:        "String",
+			format:      "%s",
+			measurement: measurements.FromKelvin(10),
+			want:        "10.00 K",
+		},
+		{
+			name:        "Value with precision",
+			format:      "%.4v",
+			measurement: measurements.FromGram(0.0125),
+			want:        "0.0125 g",
+		},
+		{
+			name:        "Long name",
+			format:      "%+v",
+			measurement: measurements.FromPoundForcePerSquareInch(30),
+			want:        "30.00 pounds-force per square inch",
+		},
+		{
+			name:        "Go syntax",
+			format:      "%#v",
+			measurement: measurements.FromKilogram(1.5),
+			want:        "measurements.NewMass(measurements.Kilogram, 1.5)",
+		},
+		{
+			name:        "Go syntax temperature",
+			format:      "%#v",
+			measurement: measurements.FromFahrenheit(-40),
+			want:        "measurements.NewTemperature(measurements.Fahrenheit, -40)",
+		},
+		{
+			name:        "Scientific",
+			format:      "%.3e",
+			measurement: measurements.FromPascal(101325),
+			want:        "1.013e+05 Pa",
+		},
+		{
+			name:        "General",
+			format:      "%g",
+			measurement: measurements.FromUSLiquidGallon(1.25),
+			want:        "1.25 gal",
+		},
+		{
+			name:        "Width",
+			format:      "[%10v]",
+			measurement: measurements.FromLiter(1),
+			want:        "[    1.00 l]",
+		},
+		{
+			name:        "Left aligned width",
+			format:      "[%-10v]",
+			measurement: measurements.FromLiter(1),
+			want:        "[1.00 l    ]",
+		},
+		{
+			name:        "Bad verb",
+			format:      "%d",
+			measurement: measurements.FromLiter(1),
+			want:        "%!d(1.00 l)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.measurement); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MolarGasConstant is R, the ideal gas constant, in J/(mol·K).
+const MolarGasConstant = 8.314462618
+
+// ErrZero is returned when a gas law would divide by a zero value.
+var ErrZero = errors.New("measurements: zero value")
+
+// GasPressure solves PV = nRT for the pressure, in pascals, of moles of an
+// ideal gas filling v at t.
+func GasPressure(v Volume, t Temperature, moles float64) (Pressure, error) {
+	m3, err := cubicMetres(v)
+	if err != nil {
+		return nil, err
+	}
+	k, err := kelvins(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMoles(moles); err != nil {
+		return nil, err
+	}
+	if m3 == 0 {
+		return nil, fmt.Errorf("%w: %s %v", ErrZero, VolumeKind, v)
+	}
+	return FromPascal(moles * MolarGasConstant * k / m3), nil
+}
+
+// GasVolume solves PV = nRT for the volume, in litres, of moles of an ideal
+// gas at p and t.
+func GasVolume(p Pressure, t Temperature, moles float64) (Volume, error) {
+	pa, err := pascals(p)
+	if err != nil {
+		return nil, err
+	}
+	k, err := kelvins(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMoles(moles); err != nil {
+		return nil, err
+	}
+	if pa == 0 {
+		return nil, fmt.Errorf("%w: %s %v", ErrZero, PressureKind, p)
+	}
+	return FromLiter(moles * MolarGasConstant * k / pa * 1000), nil
+}
+
+// GasTemperature solves PV = nRT for the temperature, in kelvins, of moles
+// of an ideal gas filling v at p.
+func GasTemperature(p Pressure, v Volume, moles float64) (Temperature, error) {
+	pa, err := pascals(p)
+	if err != nil {
+		return nil, err
+	}
+	m3, err := cubicMetres(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMoles(moles); err != nil {
+		return nil, err
+	}
+	if moles == 0 {
+		return nil, fmt.Errorf("%w: 0 mol", ErrZero)
+	}
+	return FromKelvin(pa * m3 / (moles * MolarGasConstant)), nil
+}
+
+// GasMoles solves PV = nRT for the amount of an ideal gas, in moles, that
+// fills v at p and t.
+func GasMoles(p Pressure, v Volume, t Temperature) (float64, error) {
+	pa, err := pascals(p)
+	if err != nil {
+		return 0, err
+	}
+	m3, err := cubicMetres(v)
+	if err != nil {
+		return 0, err
+	}
+	k, err := kelvins(t)
+	if err != nil {
+		return 0, err
+	}
+	if k == 0 {
+		return 0, fmt.Errorf("%w: %s %v", ErrZero, TemperatureKind, t)
+	}
+	return pa * m3 / (MolarGasConstant * k), nil
+}
+
+// GasMass is GasMoles for a gas of molarMass, in grams per mole, returning
+// its mass in grams.
+func GasMass(p Pressure, v Volume, t Temperature, molarMass float64) (Mass, error) {
+	moles, err := GasMoles(p, v, t)
+	if err != nil {
+		return nil, err
+	}
+	return MassOfMoles(moles, molarMass)
+}
+
+// Moles converts m of a substance of molarMass, in grams per mole, to an
+// amount in moles, so 18.015 g of water is one mole.
+func Moles(m Mass, molarMass float64) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0, err
+	}
+	if err := checkMolarMass(molarMass); err != nil {
+		return 0, err
+	}
+	return m.ToGram().Value() / molarMass, nil
+}
+
+// MassOfMoles converts moles of a substance of molarMass, in grams per
+// mole, to its mass in grams.
+func MassOfMoles(moles, molarMass float64) (Mass, error) {
+	if err := checkMoles(moles); err != nil {
+		return nil, err
+	}
+	if err := checkMolarMass(molarMass); err != nil {
+		return nil, err
+	}
+	return FromGram(moles * molarMass), nil
+}
+
+// CombinedGasPressure solves P1V1/T1 = P2V2/T2 for the pressure, in the
+// unit of p1, of a fixed amount of gas moved from v1 and t1 to v2 and t2.
+func CombinedGasPressure(p1 Pressure, v1 Volume, t1 Temperature, v2 Volume, t2 Temperature) (Pressure, error) {
+	moles, err := GasMoles(p1, v1, t1)
+	if err != nil {
+		return nil, err
+	}
+	p2, err := GasPressure(v2, t2, moles)
+	if err != nil {
+		return nil, err
+	}
+	return p2.ConvertTo(p1.Unit())
+}
+
+// CombinedGasVolume solves P1V1/T1 = P2V2/T2 for the volume, in the unit
+// of v1, of a fixed amount of gas moved from p1 and t1 to p2 and t2.
+func CombinedGasVolume(p1 Pressure, v1 Volume, t1 Temperature, p2 Pressure, t2 Temperature) (Volume, error) {
+	moles, err := GasMoles(p1, v1, t1)
+	if err != nil {
+		return nil, err
+	}
+	v2, err := GasVolume(p2, t2, moles)
+	if err != nil {
+		return nil, err
+	}
+	return v2.ConvertTo(v1.Unit())
+}
+
+// CombinedGasTemperature solves P1V1/T1 = P2V2/T2 for the temperature, in
+// the unit of t1, of a fixed amount of gas moved from p1 and v1 to p2 and
+// v2.
+func CombinedGasTemperature(p1 Pressure, v1 Volume, t1 Temperature, p2 Pressure, v2 Volume) (Temperature, error) {
+	moles, err := GasMoles(p1, v1, t1)
+	if err != nil {
+		return nil, err
+	}
+	t2, err := GasTemperature(p2, v2, moles)
+	if err != nil {
+		return nil, err
+	}
+	return t2.ConvertTo(t1.Unit())
+}
+
+// pascals returns the absolute pressure of p, reading gauge units against
+// the standard atmosphere.
+func pascals(p Pressure) (float64, error) {
+	if p.Unit().Reference() == Differential {
+		return 0, ErrDifferential
+	}
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return p.ToPascal().Value(), nil
+}
+
+func cubicMetres(v Volume) (float64, error) {
+	if err := v.Validate(); err != nil {
+		return 0, err
+	}
+	return v.ToLiter().Value() / 1000, nil
+}
+
+func kelvins(t Temperature) (float64, error) {
+	if err := t.Validate(); err != nil {
+		return 0, err
+	}
+	return t.ToKelvin().Value(), nil
+}
+
+func checkMoles(moles float64) error {
+	return checkAmount("moles", moles)
+}
+
+func checkMolarMass(molarMass float64) error {
+	if molarMass == 0 {
+		return fmt.Errorf("%w: molar mass", ErrZero)
+	}
+	return checkAmount("molar mass", molarMass)
+}
+
+// checkAmount is Validate for the plain numbers the gas laws take.
+func checkAmount(name string, value float64) error {
+	switch {
+	case math.IsNaN(value):
+		return fmt.Errorf("%w: %s", ErrNaN, name)
+	case math.IsInf(value, 0):
+		return fmt.Errorf("%w: %s", ErrInfinite, name)
+	case value < 0:
+		return fmt.Errorf("%w: %s %v", ErrNegative, name, value)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package measurements_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_GasLaw(t *testing.T) {
+	tests := []struct {
+		name  string
+		solve func() (measurements.Measurement, error)
+		want  string
+	}{
+		{
+			name: "Molar volume at STP",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.GasVolume(measurements.FromAtmosphere(1), measurements.FromCelsius(0), 1)
+			},
+			want: "22.41 l",
+		},
+		{
+			name: "Pressure",
+			solve: func() (measurements.Measurement, error) {
+				p, err := measurements.GasPressure(measurements.FromLiter(22.414), measurements.FromCelsius(0), 1)
+				if err != nil {
+					return nil, err
+				}
+				return p.ToAtmosphere(), nil
+			},
+			want: "1.00 atm",
+		},
+		{
+			name: "Temperature",
+			solve: func() (measurements.Measurement, error) {
+				temperature, err := measurements.GasTemperature(measurements.FromBar(2), measurements.FromLiter(12.471693927), 1)
+				if err != nil {
+					return nil, err
+				}
+				return temperature.ToCelsius(), nil
+			},
+			want: "26.85 °C",
+		},
+		{
+			name: "Gauge pressure",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.GasVolume(measurements.NewPressure(measurements.BarGauge, 0), measurements.FromKelvin(273.15), 1)
+			},
+			want: "22.41 l",
+		},
+		{
+			name: "Mass of air",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.GasMass(measurements.FromAtmosphere(1), measurements.FromLiter(22.414), measurements.FromCelsius(0), 28.97)
+			},
+			want: "28.97 g",
+		},
+		{
+			name: "Boyle's law",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.CombinedGasPressure(measurements.FromBar(1), measurements.FromLiter(10), measurements.FromCelsius(20), measurements.FromLiter(5), measurements.FromCelsius(20))
+			},
+			want: "2.00 bar",
+		},
+		{
+			name: "Charles's law",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.CombinedGasVolume(measurements.FromAtmosphere(1), measurements.FromMilliliter(1000), measurements.FromCelsius(0), measurements.FromAtmosphere(1), measurements.FromCelsius(100))
+			},
+			want: "1366.10 ml",
+		},
+		{
+			name: "Gay-Lussac's law",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.CombinedGasTemperature(measurements.FromBar(1), measurements.FromLiter(1), measurements.FromKelvin(300), measurements.FromBar(2), measurements.FromLiter(1))
+			},
+			want: "600.00 K",
+		},
+		{
+			name: "Compressed tyre",
+			solve: func() (measurements.Measurement, error) {
+				return measurements.CombinedGasPressure(measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 30), measurements.FromLiter(1), measurements.FromCelsius(20), measurements.FromLiter(0.5), measurements.FromCelsius(20))
+			},
+			want: "74.70 psig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.solve()
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_GasMoles(t *testing.T) {
+	got, err := measurements.GasMoles(measurements.FromPascal(101325), measurements.FromLiter(22.413969545), measurements.FromKelvin(273.15))
+	if err != nil {
+		t.Fatalf("GasMoles() error = %v", err)
+	}
+	if math.Abs(got-1) > 1e-9 {
+		t.Errorf("GasMoles() = %v, want 1", got)
+	}
+
+	moles, err := measurements.Moles(measurements.FromKilogram(0.018015), 18.015)
+	if err != nil {
+		t.Fatalf("Moles() error = %v", err)
+	}
+	if math.Abs(moles-1) > 1e-9 {
+		t.Errorf("Moles() = %v, want 1", moles)
+	}
+}
+
+func Test_GasLaw_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		solve   func() error
+		wantErr error
+	}{
+		{
+			name: "Differential pressure",
+			solve: func() error {
+				_, err := measurements.GasVolume(measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 5), measurements.FromKelvin(300), 1)
+				return err
+			},
+			wantErr: measurements.ErrDifferential,
+		},
+		{
+			name: "Zero volume",
+			solve: func() error {
+				_, err := measurements.GasPressure(measurements.FromLiter(0), measurements.FromKelvin(300), 1)
+				return err
+			},
+			wantErr: measurements.ErrZero,
+		},
+		{
+			name: "Absolute zero",
+			solve: func() error {
+				_, err := measurements.GasMoles(measurements.FromBar(1), measurements.FromLiter(1), measurements.FromKelvin(0))
+				return err
+			},
+			wantErr: measurements.ErrZero,
+		},
+		{
+			name: "Below absolute zero",
+			solve: func() error {
+				_, err := measurements.GasVolume(measurements.FromBar(1), measurements.FromCelsius(-300), 1)
+				return err
+			},
+			wantErr: measurements.ErrBelowAbsoluteZero,
+		},
+		{
+			name: "Negative moles",
+			solve: func() error {
+				_, err := measurements.GasPressure(measurements.FromLiter(1), measurements.FromKelvin(300), -1)
+				return err
+			},
+			wantErr: measurements.ErrNegative,
+		},
+		{
+			name: "NaN moles",
+			solve: func() error {
+				_, err := measurements.GasTemperature(measurements.FromBar(1), measurements.FromLiter(1), math.NaN())
+				return err
+			},
+			wantErr: measurements.ErrNaN,
+		},
+		{
+			name: "Zero molar mass",
+			solve: func() error {
+				_, err := measurements.Moles(measurements.FromGram(1), 0)
+				return err
+			},
+			wantErr: measurements.ErrZero,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.solve(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/RossMerr/go-measurements
 
-go 1.14
\ No newline at end of file
+go 1.23
+
+require google.golang.org/protobuf v1.36.11
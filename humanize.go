@@ -0,0 +1,229 @@
+package measurements
+
+import (
+	"math"
+	"strings"
+)
+
+// HumanizeOptions controls how BestUnit picks a unit for display.
+type HumanizeOptions struct {
+	// System restricts the units to choose from. Zero uses the systems of
+	// the measurement's unit, or SI for units outside every system.
+	System System
+	// Min and Max bound the magnitude of the value in the chosen unit, from
+	// Min inclusive to Max exclusive. Zero uses 1 and 1000.
+	Min float64
+	Max float64
+	// SignificantFigures is the number of digits Humanize writes, without
+	// trailing zeros. Zero uses 4.
+	SignificantFigures int
+}
+
+// DefaultHumanizeOptions keeps values between 1 and 1000 in the unit system
+// of the measurement.
+var DefaultHumanizeOptions = HumanizeOptions{Min: 1, Max: 1000, SignificantFigures: 4}
+
+// BestUnit returns the unit that shows m most readably: the largest unit of
+// opts.System that puts the value between opts.Min and opts.Max, or the one
+// that comes closest when none does. The units include the multiples of a
+// thousand of prefixable units, such as kPa and MPa. SI measurements stay
+// in the same decimal family when they can, so pascals become kilopascals
+// rather than bars.
+func BestUnit(m Measurement, opts HumanizeOptions) Unit {
+	info, ok := bestUnit(m.measurementUnit(), m.Value(), opts)
+	if !ok {
+		return m.measurementUnit()
+	}
+	return info.Unit
+}
+
+// Humanize converts m to its BestUnit and formats it, such as "1.25 t" for
+// 1,250,000 grams or "101.3 kPa" for 101,325 pascals.
+func Humanize(m Measurement, opts HumanizeOptions) string {
+	opts = opts.withDefaults()
+	unit, value := humanizeValue(m.measurementUnit(), m.Value(), opts)
+	// Rounding can carry the value up to opts.Max, as 999.96 kPa does to
+	// 1000 kPa, so the unit is picked again for the rounded value.
+	if rounded, _ := roundSignificant(value, opts.SignificantFigures); rounded != value {
+		unit, value = humanizeValue(unit, rounded, opts)
+	}
+
+	number := formatNumber(value, FormatOptions{SignificantFigures: opts.SignificantFigures})
+	if strings.Contains(number, ".") {
+		number = strings.TrimRight(strings.TrimRight(number, "0"), ".")
+	}
+	return number + " " + unit.Symbol()
+}
+
+// humanizeValue converts value to its best unit, or leaves it in unit when
+// there is none.
+func humanizeValue(unit Unit, value float64, opts HumanizeOptions) (Unit, float64) {
+	if info, ok := bestUnit(unit, value, opts); ok {
+		if converted, err := convertValue(unit.Kind(), value, unit, info.Unit); err == nil {
+			return info.Unit, converted
+		}
+	}
+	return unit, value
+}
+
+func (s HumanizeOptions) withDefaults() HumanizeOptions {
+	if s.Min <= 0 {
+		s.Min = DefaultHumanizeOptions.Min
+	}
+	if s.Max <= s.Min {
+		s.Max = math.Max(DefaultHumanizeOptions.Max, s.Min*1000)
+	}
+	if s.SignificantFigures <= 0 {
+		s.SignificantFigures = DefaultHumanizeOptions.SignificantFigures
+	}
+	return s
+}
+
+// humanizeCandidate is a unit BestUnit may pick. Prefixed multiples are
+// only registered once chosen, so root and prefix are kept to build them.
+type humanizeCandidate struct {
+	info   UnitInfo
+	root   UnitInfo
+	prefix Prefix
+}
+
+func bestUnit(unit Unit, value float64, opts HumanizeOptions) (UnitInfo, bool) {
+	opts = opts.withDefaults()
+	kind := unit.Kind()
+	from, ok := lookupUnitInfo(kind, unit)
+	if !ok {
+		return UnitInfo{}, false
+	}
+	system := opts.System
+	if system == 0 {
+		system = from.System
+	}
+	if system == 0 {
+		system = SI
+	}
+
+	base := from.ToBase(value)
+	if value == 0 || math.IsNaN(base) || math.IsInf(base, 0) {
+		if from.System&system != 0 {
+			return from, true
+		}
+		closest, ok := closestUnit(kind, from, system)
+		return closest, ok && closest.Offset == from.Offset
+	}
+
+	candidates := humanizeCandidates(from, system)
+	best, found := pickCandidate(candidates, base, opts, func(c humanizeCandidate) bool {
+		return from.System.Has(SI) && isDecimalMultiple(c.info, from)
+	})
+	if !found {
+		best, found = pickCandidate(candidates, base, opts, nil)
+	}
+	if !found {
+		return UnitInfo{}, false
+	}
+	if best.info.Unit != nil {
+		return best.info, true
+	}
+	info, err := prefixedUnit(best.root, best.prefix)
+	return info, err == nil
+}
+
+// pickCandidate returns the largest of the candidates accepted by filter
+// that puts base between opts.Min and opts.Max. A nil filter accepts every
+// candidate and, when none is in range, returns the one that comes closest.
+func pickCandidate(candidates []humanizeCandidate, base float64, opts HumanizeOptions, filter func(humanizeCandidate) bool) (humanizeCandidate, bool) {
+	var (
+		best     humanizeCandidate
+		bestSize float64
+		closest  humanizeCandidate
+		distance = math.Inf(1)
+		found    bool
+	)
+	lo, hi := math.Log(opts.Min), math.Log(opts.Max)
+	for _, candidate := range candidates {
+		if filter != nil && !filter(candidate) {
+			continue
+		}
+		v := math.Log(math.Abs(candidate.info.FromBase(base)))
+		if lo <= v && v < hi {
+			if !found || candidate.info.Factor > bestSize {
+				best, bestSize, found = candidate, candidate.info.Factor, true
+			}
+			continue
+		}
+		if d := math.Min(math.Abs(v-lo), math.Abs(v-hi)); d < distance {
+			closest, distance = candidate, d
+		}
+	}
+	if found {
+		return best, true
+	}
+	return closest, filter == nil && !math.IsInf(distance, 1)
+}
+
+// isDecimalMultiple reports whether info is from scaled by a power of a
+// thousand, as the kilogram is of the gram or the tonne of the kilogram.
+func isDecimalMultiple(info, from UnitInfo) bool {
+	if info.Offset != from.Offset {
+		return false
+	}
+	exponent := math.Log10(info.Factor/from.Factor) / 3
+	return math.Abs(exponent-math.Round(exponent)) < 1e-9
+}
+
+// humanizeCandidates lists the units of from's kind in system with the same
+// reference and offset as from: every unit other than prefixed ones, plus
+// the multiples of a thousand of each prefixable unit that do not duplicate
+// a unit already listed. Changing the offset would change the number rather
+// than its scale, so a temperature keeps its unit, and kelvins, which have
+// offset siblings, are not prefixed.
+func humanizeCandidates(from UnitInfo, system System) []humanizeCandidate {
+	kind := from.Kind
+	var candidates []humanizeCandidate
+	units := registeredUnits(kind)
+	for _, info := range units {
+		if info.root == nil && info.System&system != 0 && info.Offset == from.Offset && sameReference(info.Unit, from.Unit) {
+			candidates = append(candidates, humanizeCandidate{info: info})
+		}
+	}
+
+	named := len(candidates)
+	for _, root := range candidates[:named] {
+		if !root.info.Prefixable || hasOffsetSibling(root.info, units) {
+			continue
+		}
+		for _, prefix := range Prefixes {
+			if prefix%3 != 0 {
+				continue
+			}
+			factor := root.info.Factor * prefix.Factor()
+			duplicate := false
+			for _, other := range candidates[:named] {
+				if math.Abs(other.info.Factor-factor) <= 1e-9*factor && other.info.Offset == 0 {
+					duplicate = true
+					break
+				}
+			}
+			if duplicate {
+				continue
+			}
+			candidates = append(candidates, humanizeCandidate{
+				info:   UnitInfo{Kind: kind, Factor: factor},
+				root:   root.info,
+				prefix: prefix,
+			})
+		}
+	}
+	return candidates
+}
+
+// hasOffsetSibling reports whether a unit of the same reference as info
+// has a different offset, as Celsius does to the kelvin.
+func hasOffsetSibling(info UnitInfo, units []UnitInfo) bool {
+	for _, other := range units {
+		if other.Offset != info.Offset && sameReference(other.Unit, info.Unit) {
+			return true
+		}
+	}
+	return false
+}
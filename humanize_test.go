@@ -0,0 +1,190 @@
+package measurements_test
+
+import (
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Humanize(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	tests := []struct {
+		name string
+		m    measurements.Measurement
+		opts measurements.HumanizeOptions
+		want string
+	}{
+		{
+			name: "Grams to tonnes",
+			m:    measurements.FromGram(1_250_000),
+			want: "1.25 t",
+		},
+		{
+			name: "Pascals to kilopascals",
+			m:    measurements.FromPascal(101325),
+			want: "101.3 kPa",
+		},
+		{
+			name: "Kilograms to grams",
+			m:    measurements.FromKilogram(0.25),
+			want: "250 g",
+		},
+		{
+			name: "Already readable",
+			m:    measurements.FromKilogram(70),
+			want: "70 kg",
+		},
+		{
+			name: "Milligrams",
+			m:    measurements.FromGram(0.0042),
+			want: "4.2 mg",
+		},
+		{
+			name: "US customary",
+			m:    measurements.FromOunce(40),
+			opts: measurements.HumanizeOptions{System: measurements.USCustomary},
+			want: "2.5 lb",
+		},
+		{
+			name: "Metric to US customary",
+			m:    measurements.FromLiter(7.570823568),
+			opts: measurements.HumanizeOptions{System: measurements.USCustomary},
+			want: "2 gal",
+		},
+		{
+			name: "Imperial",
+			m:    measurements.FromMilliliter(568.26125),
+			opts: measurements.HumanizeOptions{System: measurements.Imperial},
+			want: "1 imp pt",
+		},
+		{
+			name: "Lower range",
+			m:    measurements.FromPascal(101325),
+			opts: measurements.HumanizeOptions{Min: 0.1, Max: 100},
+			want: "0.1013 MPa",
+		},
+		{
+			name: "Rounding carries to the next unit",
+			m:    measurements.FromKilopascal(999.96),
+			want: "1 MPa",
+		},
+		{
+			name: "Negative",
+			m:    measurements.FromGram(-1500),
+			want: "-1.5 kg",
+		},
+		{
+			name: "Zero",
+			m:    measurements.FromGram(0),
+			want: "0 g",
+		},
+		{
+			name: "Just above freezing",
+			m:    measurements.FromCelsius(0.5),
+			want: "0.5 °C",
+		},
+		{
+			name: "Just below freezing",
+			m:    measurements.FromCelsius(-0.2),
+			want: "-0.2 °C",
+		},
+		{
+			name: "Hot Celsius",
+			m:    measurements.FromCelsius(1500),
+			want: "1500 °C",
+		},
+		{
+			name: "Hot Kelvin",
+			m:    measurements.FromKelvin(1500),
+			want: "1500 K",
+		},
+		{
+			name: "Temperature in another system",
+			m:    measurements.FromCelsius(20),
+			opts: measurements.HumanizeOptions{System: measurements.USCustomary},
+			want: "20 °C",
+		},
+		{
+			name: "Zero temperature in another system",
+			m:    measurements.FromCelsius(0),
+			opts: measurements.HumanizeOptions{System: measurements.USCustomary},
+			want: "0 °C",
+		},
+		{
+			name: "Gauge stays gauge",
+			m:    measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 30),
+			want: "30 psig",
+		},
+		{
+			name: "Gauge in another system",
+			m:    measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 30),
+			opts: measurements.HumanizeOptions{System: measurements.SI},
+			want: "2.068 barg",
+		},
+		{
+			name: "Differential stays differential",
+			m:    measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 5),
+			opts: measurements.HumanizeOptions{System: measurements.SI},
+			want: "5 psid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.Humanize(tt.m, tt.opts); got != tt.want {
+				t.Errorf("Humanize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_BestMassUnit(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurements.Mass
+		opts measurements.HumanizeOptions
+		want measurements.MassUnit
+	}{
+		{
+			name: "Tonne",
+			m:    measurements.FromKilogram(2500),
+			want: measurements.Tonne,
+		},
+		{
+			name: "Gram",
+			m:    measurements.FromPound(0.5),
+			opts: measurements.HumanizeOptions{System: measurements.SI},
+			want: measurements.Gram,
+		},
+		{
+			name: "Pound",
+			m:    measurements.FromKilogram(3),
+			opts: measurements.HumanizeOptions{System: measurements.Imperial},
+			want: measurements.Pound,
+		},
+		{
+			name: "Stone",
+			m:    measurements.FromKilogram(70),
+			opts: measurements.HumanizeOptions{System: measurements.Imperial},
+			want: measurements.Stone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.BestMassUnit(tt.m, tt.opts); got != tt.want {
+				t.Errorf("BestMassUnit() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Humanize_noRegistration(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	before := len(measurements.Units(measurements.TemperatureKind))
+	measurements.Humanize(measurements.FromKelvin(1_500_000), measurements.DefaultHumanizeOptions)
+	measurements.Humanize(measurements.FromCelsius(1500), measurements.DefaultHumanizeOptions)
+	if after := len(measurements.Units(measurements.TemperatureKind)); after != before {
+		t.Errorf("Humanize() registered %d temperature units", after-before)
+	}
+}
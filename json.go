@@ -0,0 +1,163 @@
+package measurements
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MassValue wraps a Mass so it can be used as a field in encoded structs.
+// A nil Mass encodes as null.
+type MassValue struct {
+	Mass
+	// Compact encodes the mass as a "1.5 kg" string rather than an object.
+	Compact bool
+}
+
+// PressureValue wraps a Pressure so it can be used as a field in encoded
+// structs. A nil Pressure encodes as null.
+type PressureValue struct {
+	Pressure
+	// Compact encodes the pressure as a "1.5 bar" string rather than an object.
+	Compact bool
+}
+
+// VolumeValue wraps a Volume so it can be used as a field in encoded
+// structs. A nil Volume encodes as null.
+type VolumeValue struct {
+	Volume
+	// Compact encodes the volume as a "1.5 l" string rather than an object.
+	Compact bool
+}
+
+// TemperatureValue wraps a Temperature so it can be used as a field in
+// encoded structs. A nil Temperature encodes as null.
+type TemperatureValue struct {
+	Temperature
+	// Compact encodes the temperature as a "21.5 °C" string rather than an
+	// object.
+	Compact bool
+}
+
+type jsonMeasurement struct {
+	Value *float64 `json:"value"`
+	Unit  string   `json:"unit"`
+}
+
+func marshalMeasurement(value float64, unit Unit) ([]byte, error) {
+	return json.Marshal(jsonMeasurement{Value: &value, Unit: unit.String()})
+}
+
+func marshalValue(m Measurement, compact bool) ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	if compact {
+		return json.Marshal(compactString(m))
+	}
+	return json.Marshal(m)
+}
+
+// unmarshalMeasurement decodes either the object form {"value":1.5,"unit":"kg"}
+// or the compact string form "1.5 kg", reporting which one it found.
+func unmarshalMeasurement(kind Kind, data []byte) (float64, UnitInfo, bool, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, UnitInfo{}, false, err
+		}
+		value, info, err := parseMeasurement(kind, s)
+		return value, info, true, err
+	}
+
+	var m jsonMeasurement
+	if err := json.Unmarshal(data, &m); err != nil {
+		return 0, UnitInfo{}, false, err
+	}
+	if m.Value == nil {
+		return 0, UnitInfo{}, false, fmt.Errorf("%w: %s is missing a value", ErrSyntax, kind)
+	}
+	info, ok := LookupUnit(kind, m.Unit)
+	if !ok {
+		return 0, UnitInfo{}, false, fmt.Errorf("%w: %s %q", ErrUnknownUnit, kind, m.Unit)
+	}
+	return *m.Value, info, false, nil
+}
+
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// compactString writes m with as many digits as it needs to round trip.
+func compactString(m Measurement) string {
+	return Format(m, FormatOptions{Precision: -1})
+}
+
+func (s MassValue) MarshalJSON() ([]byte, error) {
+	return marshalValue(s.Mass, s.Compact)
+}
+
+func (s *MassValue) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		s.Mass = nil
+		return nil
+	}
+	value, info, compact, err := unmarshalMeasurement(MassKind, data)
+	if err != nil {
+		return err
+	}
+	s.Mass, s.Compact = NewMass(info.Unit.(MassUnit), value), compact
+	return nil
+}
+
+func (s PressureValue) MarshalJSON() ([]byte, error) {
+	return marshalValue(s.Pressure, s.Compact)
+}
+
+func (s *PressureValue) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		s.Pressure = nil
+		return nil
+	}
+	value, info, compact, err := unmarshalMeasurement(PressureKind, data)
+	if err != nil {
+		return err
+	}
+	s.Pressure, s.Compact = NewPressure(info.Unit.(PressureUnit), value), compact
+	return nil
+}
+
+func (s VolumeValue) MarshalJSON() ([]byte, error) {
+	return marshalValue(s.Volume, s.Compact)
+}
+
+func (s *VolumeValue) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		s.Volume = nil
+		return nil
+	}
+	value, info, compact, err := unmarshalMeasurement(VolumeKind, data)
+	if err != nil {
+		return err
+	}
+	s.Volume, s.Compact = NewVolume(info.Unit.(VolumeType), value), compact
+	return nil
+}
+
+func (s TemperatureValue) MarshalJSON() ([]byte, error) {
+	return marshalValue(s.Temperature, s.Compact)
+}
+
+func (s *TemperatureValue) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		s.Temperature = nil
+		return nil
+	}
+	value, info, compact, err := unmarshalMeasurement(TemperatureKind, data)
+	if err != nil {
+		return err
+	}
+	s.Temperature, s.Compact = NewTemperature(info.Unit.(TemperatureUnit), value), compact
+	return nil
+}
@@ -0,0 +1,105 @@
+package measurements_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+type payload struct {
+	Weight      measurements.MassValue        `json:"weight"`
+	Pressure    measurements.PressureValue    `json:"pressure"`
+	Volume      measurements.VolumeValue      `json:"volume"`
+	Temperature measurements.TemperatureValue `json:"temperature"`
+}
+
+func Test_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{
+			name:  "Mass interface",
+			input: measurements.FromKilogram(1.5),
+			want:  `{"value":1.5,"unit":"kg"}`,
+		},
+		{
+			name: "Struct fields",
+			input: payload{
+				Weight:      measurements.MassValue{Mass: measurements.FromPound(2)},
+				Pressure:    measurements.PressureValue{Pressure: measurements.FromBar(1.013), Compact: true},
+				Volume:      measurements.VolumeValue{Volume: measurements.FromImperialPint(1)},
+				Temperature: measurements.TemperatureValue{Temperature: measurements.FromCelsius(-4.25), Compact: true},
+			},
+			want: `{"weight":{"value":2,"unit":"lb"},"pressure":"1.013 bar","volume":{"value":1,"unit":"imp pt"},"temperature":"-4.25 °C"}`,
+		},
+		{
+			name:  "Nil fields",
+			input: payload{},
+			want:  `{"weight":null,"pressure":null,"volume":null,"temperature":null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_UnmarshalJSON(t *testing.T) {
+	var got payload
+	input := `{"weight":"11 lb","pressure":{"value":101325,"unit":"Pa"},"volume":{"value":2,"unit":"litres"},"temperature":null}`
+	if err := json.Unmarshal([]byte(input), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := measurements.FromPound(11); got.Weight.String() != want.String() || !got.Weight.Compact {
+		t.Errorf("Weight = %v, want compact %v", got.Weight, want)
+	}
+	if want := measurements.FromPascal(101325); got.Pressure.String() != want.String() || got.Pressure.Compact {
+		t.Errorf("Pressure = %v, want %v", got.Pressure, want)
+	}
+	if want := measurements.FromLiter(2); got.Volume.String() != want.String() {
+		t.Errorf("Volume = %v, want %v", got.Volume, want)
+	}
+	if got.Temperature.Temperature != nil {
+		t.Errorf("Temperature = %v, want nil", got.Temperature)
+	}
+
+	roundTrip, err := json.Marshal(got.Weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(roundTrip) != `"11 lb"` {
+		t.Errorf("Marshal() = %s, want %s", roundTrip, `"11 lb"`)
+	}
+}
+
+func Test_UnmarshalJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{name: "Unknown unit", input: `{"value":1,"unit":"Pa"}`, wantErr: measurements.ErrUnknownUnit},
+		{name: "Missing value", input: `{"unit":"kg"}`, wantErr: measurements.ErrSyntax},
+		{name: "Bad string", input: `"heavy"`, wantErr: measurements.ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got measurements.MassValue
+			if err := json.Unmarshal([]byte(tt.input), &got); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
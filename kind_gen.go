@@ -0,0 +1,106 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements
+
+// Kind identifies the physical quantity a unit measures.
+type Kind int32
+
+const (
+	MassKind Kind = iota
+	PressureKind
+	VolumeKind
+	TemperatureKind
+)
+
+var KindName = map[Kind]string{
+	MassKind:        "mass",
+	PressureKind:    "pressure",
+	VolumeKind:      "volume",
+	TemperatureKind: "temperature",
+}
+
+var kindConstructor = map[Kind]string{
+	MassKind:        "NewMass",
+	PressureKind:    "NewPressure",
+	VolumeKind:      "NewVolume",
+	TemperatureKind: "NewTemperature",
+}
+
+var kinds = []Kind{MassKind, PressureKind, VolumeKind, TemperatureKind}
+
+var unitRegistry = map[Kind][]UnitInfo{
+	MassKind:        massUnits,
+	PressureKind:    pressureUnits,
+	VolumeKind:      volumeUnits,
+	TemperatureKind: temperatureUnits,
+}
+
+// kindLimits holds the smallest physically possible value of each kind in
+// its base unit.
+var kindLimits = map[Kind]limit{
+	MassKind:        {min: 0, err: ErrNegative},
+	PressureKind:    {min: 0, err: ErrNegative},
+	VolumeKind:      {min: 0, err: ErrNegative},
+	TemperatureKind: {min: 0, err: ErrBelowAbsoluteZero},
+}
+
+// unitCode returns the enum value the binary and stream encodings store
+// for unit, a unit of kind. Only built-in units have a code: units
+// registered at run time, prefixed ones included, are numbered in the order
+// they are added, which differs from one process to the next.
+func unitCode(kind Kind, unit Unit) (int32, bool) {
+	if unit == nil || unit.Kind() != kind {
+		return 0, false
+	}
+	switch unit := unit.(type) {
+	case MassUnit:
+		return int32(unit), unit >= 0 && int(unit) < len(massUnits)
+	case PressureUnit:
+		return int32(unit), unit >= 0 && int(unit) < len(pressureUnits)
+	case VolumeType:
+		return int32(unit), unit >= 0 && int(unit) < len(volumeUnits)
+	case TemperatureUnit:
+		return int32(unit), unit >= 0 && int(unit) < len(temperatureUnits)
+	}
+	return 0, false
+}
+
+// unitFromCode is the inverse of unitCode.
+func unitFromCode(kind Kind, code int32) (Unit, bool) {
+	unit := makeUnit(kind, code)
+	if unit == nil {
+		return nil, false
+	}
+	_, ok := unitCode(kind, unit)
+	return unit, ok
+}
+
+// makeUnit returns the unit of kind with enum value code, whether or not it
+// is registered.
+func makeUnit(kind Kind, code int32) Unit {
+	switch kind {
+	case MassKind:
+		return MassUnit(code)
+	case PressureKind:
+		return PressureUnit(code)
+	case VolumeKind:
+		return VolumeType(code)
+	case TemperatureKind:
+		return TemperatureUnit(code)
+	}
+	return nil
+}
+
+func newMeasurement(kind Kind, unit Unit, value float64) Measurement {
+	switch kind {
+	case MassKind:
+		return NewMass(unit.(MassUnit), value)
+	case PressureKind:
+		return NewPressure(unit.(PressureUnit), value)
+	case VolumeKind:
+		return NewVolume(unit.(VolumeType), value)
+	case TemperatureKind:
+		return NewTemperature(unit.(TemperatureUnit), value)
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+package measurements
+
+import (
+	"math"
+	"strings"
+	"sync"
+)
+
+// UnitName is the translated singular and plural name of a unit.
+type UnitName struct {
+	Singular string
+	Plural   string
+}
+
+// Locale describes how numbers and unit names are written in a language.
+type Locale struct {
+	// Tag is the BCP 47 language tag, such as "de" or "fr-CA".
+	Tag     string
+	Decimal string
+	// Group separates thousands in the integer part; empty disables grouping.
+	Group string
+	// MinimumGroupingDigits is the number of digits that must precede the
+	// first group separator, so a value of 2 writes 1234 but 12.345.
+	MinimumGroupingDigits int
+	// PluralRule reports whether the plural name is used for value. A nil
+	// rule uses the singular only for exactly one.
+	PluralRule func(value float64) bool
+	// Names translates unit names; units missing from it use English.
+	Names map[Unit]UnitName
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{}
+)
+
+// RegisterLocale adds locale to the table used by LookupLocale, replacing
+// any locale with the same tag.
+func RegisterLocale(locale Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[normalizeTag(locale.Tag)] = locale
+}
+
+// LookupLocale finds the locale registered for tag, falling back to its
+// language when there is no exact match, so "de-AT" finds "de".
+func LookupLocale(tag string) (*Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	tag = normalizeTag(tag)
+	for {
+		if locale, ok := locales[tag]; ok {
+			return &locale, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return nil, false
+		}
+		tag = tag[:i]
+	}
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Replace(tag, "_", "-", -1))
+}
+
+func (s *Locale) formatNumber(number string) string {
+	if s == nil {
+		return number
+	}
+
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+
+	integer, fraction := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+
+	minimum := s.MinimumGroupingDigits
+	if minimum < 1 {
+		minimum = 1
+	}
+	if s.Group != "" && len(integer) >= 3+minimum && isDigits(integer) {
+		var b strings.Builder
+		head := len(integer) % 3
+		if head == 0 {
+			head = 3
+		}
+		b.WriteString(integer[:head])
+		for i := head; i < len(integer); i += 3 {
+			b.WriteString(s.Group)
+			b.WriteString(integer[i : i+3])
+		}
+		integer = b.String()
+	}
+
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + s.Decimal + fraction
+}
+
+func (s *Locale) unitName(value float64, unit Unit) string {
+	if s == nil {
+		return unitName(value, unit)
+	}
+
+	plural := math.Abs(value) != 1
+	if s.PluralRule != nil {
+		plural = s.PluralRule(value)
+	}
+
+	name, ok := s.Names[unit]
+	switch {
+	case ok && plural:
+		return name.Plural
+	case ok:
+		return name.Singular
+	case plural:
+		return unit.Plural()
+	default:
+		return unit.Singular()
+	}
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func init() {
+	for _, locale := range []Locale{english, german, french, spanish, japanese} {
+		RegisterLocale(locale)
+	}
+}
+
+var english = Locale{
+	Tag:     "en",
+	Decimal: ".",
+	Group:   ",",
+}
+
+var german = Locale{
+	Tag:     "de",
+	Decimal: ",",
+	Group:   ".",
+	Names: map[Unit]UnitName{
+		Kilogram:                            {"Kilogramm", "Kilogramm"},
+		Gram:                                {"Gramm", "Gramm"},
+		Pound:                               {"Pfund", "Pfund"},
+		Ounce:                               {"Unze", "Unzen"},
+		Tonne:                               {"Tonne", "Tonnen"},
+		Stone:                               {"Stone", "Stone"},
+		Milligram:                           {"Milligramm", "Milligramm"},
+		Microgram:                           {"Mikrogramm", "Mikrogramm"},
+		ShortTon:                            {"amerikanische Tonne", "amerikanische Tonnen"},
+		LongTon:                             {"britische Tonne", "britische Tonnen"},
+		Grain:                               {"Grain", "Grain"},
+		Dram:                                {"Dram", "Dram"},
+		TroyOunce:                           {"Feinunze", "Feinunzen"},
+		TroyPound:                           {"Troy-Pfund", "Troy-Pfund"},
+		Pennyweight:                         {"Pennyweight", "Pennyweight"},
+		Carat:                               {"Karat", "Karat"},
+		AtomicMassUnit:                      {"atomare Masseneinheit", "atomare Masseneinheiten"},
+		Slug:                                {"Slug", "Slugs"},
+		Torr:                                {"Torr", "Torr"},
+		Bar:                                 {"Bar", "Bar"},
+		Pascal:                              {"Pascal", "Pascal"},
+		PoundForcePerSquareInch:             {"Pfund pro Quadratzoll", "Pfund pro Quadratzoll"},
+		PoundForcePerSquareInchGauge:        {"Pfund pro Quadratzoll Überdruck", "Pfund pro Quadratzoll Überdruck"},
+		BarGauge:                            {"Bar Überdruck", "Bar Überdruck"},
+		PoundForcePerSquareInchDifferential: {"Pfund pro Quadratzoll Differenzdruck", "Pfund pro Quadratzoll Differenzdruck"},
+		Atmosphere:                          {"physikalische Atmosphäre", "physikalische Atmosphären"},
+		TechnicalAtmosphere:                 {"technische Atmosphäre", "technische Atmosphären"},
+		MillimetreOfMercury:                 {"Millimeter Quecksilbersäule", "Millimeter Quecksilbersäule"},
+		InchOfMercury:                       {"Zoll Quecksilbersäule", "Zoll Quecksilbersäule"},
+		CentimetreOfWater:                   {"Zentimeter Wassersäule", "Zentimeter Wassersäule"},
+		InchOfWater:                         {"Zoll Wassersäule", "Zoll Wassersäule"},
+		Millibar:                            {"Millibar", "Millibar"},
+		Hectopascal:                         {"Hektopascal", "Hektopascal"},
+		Kilopascal:                          {"Kilopascal", "Kilopascal"},
+		Megapascal:                          {"Megapascal", "Megapascal"},
+		KilopoundForcePerSquareInch:         {"Kip pro Quadratzoll", "Kip pro Quadratzoll"},
+		Milliliter:                          {"Milliliter", "Milliliter"},
+		Litre:                               {"Liter", "Liter"},
+		USfluidOunce:                        {"US-Flüssigunze", "US-Flüssigunzen"},
+		USlegalCup:                          {"US-Tasse", "US-Tassen"},
+		USliquidPint:                        {"US-Pint", "US-Pints"},
+		USLiquidQuart:                       {"US-Quart", "US-Quarts"},
+		USLiquidGallon:                      {"US-Gallone", "US-Gallonen"},
+		ImperialFluidOunce:                  {"britische Flüssigunze", "britische Flüssigunzen"},
+		ImperialCup:                         {"britische Tasse", "britische Tassen"},
+		ImperialPint:                        {"britisches Pint", "britische Pints"},
+		ImperialQuart:                       {"britisches Quart", "britische Quarts"},
+		ImperialGallon:                      {"britische Gallone", "britische Gallonen"},
+		Celsius:                             {"Grad Celsius", "Grad Celsius"},
+		Fahrenheit:                          {"Grad Fahrenheit", "Grad Fahrenheit"},
+		Kelvin:                              {"Kelvin", "Kelvin"},
+		Rankine:                             {"Grad Rankine", "Grad Rankine"},
+		Reaumur:                             {"Grad Réaumur", "Grad Réaumur"},
+		Romer:                               {"Grad Rømer", "Grad Rømer"},
+		Delisle:                             {"Grad Delisle", "Grad Delisle"},
+		Newton:                              {"Grad Newton", "Grad Newton"},
+	},
+}
+
+var french = Locale{
+	Tag:     "fr",
+	Decimal: ",",
+	Group:   " ",
+	PluralRule: func(value float64) bool {
+		return math.Abs(value) >= 2
+	},
+	Names: map[Unit]UnitName{
+		Kilogram:                            {"kilogramme", "kilogrammes"},
+		Gram:                                {"gramme", "grammes"},
+		Pound:                               {"livre", "livres"},
+		Ounce:                               {"once", "onces"},
+		Tonne:                               {"tonne", "tonnes"},
+		Stone:                               {"stone", "stones"},
+		Milligram:                           {"milligramme", "milligrammes"},
+		Microgram:                           {"microgramme", "microgrammes"},
+		ShortTon:                            {"tonne courte", "tonnes courtes"},
+		LongTon:                             {"tonne longue", "tonnes longues"},
+		Grain:                               {"grain", "grains"},
+		Dram:                                {"drachme", "drachmes"},
+		TroyOunce:                           {"once troy", "onces troy"},
+		TroyPound:                           {"livre troy", "livres troy"},
+		Pennyweight:                         {"pennyweight", "pennyweights"},
+		Carat:                               {"carat", "carats"},
+		AtomicMassUnit:                      {"unité de masse atomique", "unités de masse atomique"},
+		Slug:                                {"slug", "slugs"},
+		Torr:                                {"torr", "torrs"},
+		Bar:                                 {"bar", "bars"},
+		Pascal:                              {"pascal", "pascals"},
+		PoundForcePerSquareInch:             {"livre-force par pouce carré", "livres-force par pouce carré"},
+		PoundForcePerSquareInchGauge:        {"livre-force par pouce carré relative", "livres-force par pouce carré relatives"},
+		BarGauge:                            {"bar relatif", "bars relatifs"},
+		PoundForcePerSquareInchDifferential: {"livre-force par pouce carré différentielle", "livres-force par pouce carré différentielles"},
+		Atmosphere:                          {"atmosphère normale", "atmosphères normales"},
+		TechnicalAtmosphere:                 {"atmosphère technique", "atmosphères techniques"},
+		MillimetreOfMercury:                 {"millimètre de mercure", "millimètres de mercure"},
+		InchOfMercury:                       {"pouce de mercure", "pouces de mercure"},
+		CentimetreOfWater:                   {"centimètre d'eau", "centimètres d'eau"},
+		InchOfWater:                         {"pouce d'eau", "pouces d'eau"},
+		Millibar:                            {"millibar", "millibars"},
+		Hectopascal:                         {"hectopascal", "hectopascals"},
+		Kilopascal:                          {"kilopascal", "kilopascals"},
+		Megapascal:                          {"mégapascal", "mégapascals"},
+		KilopoundForcePerSquareInch:         {"kip par pouce carré", "kips par pouce carré"},
+		Milliliter:                          {"millilitre", "millilitres"},
+		Litre:                               {"litre", "litres"},
+		USfluidOunce:                        {"once liquide américaine", "onces liquides américaines"},
+		USlegalCup:                          {"tasse américaine", "tasses américaines"},
+		USliquidPint:                        {"pinte américaine", "pintes américaines"},
+		USLiquidQuart:                       {"quart américain", "quarts américains"},
+		USLiquidGallon:                      {"gallon américain", "gallons américains"},
+		ImperialFluidOunce:                  {"once liquide impériale", "onces liquides impériales"},
+		ImperialCup:                         {"tasse impériale", "tasses impériales"},
+		ImperialPint:                        {"pinte impériale", "pintes impériales"},
+		ImperialQuart:                       {"quart impérial", "quarts impériaux"},
+		ImperialGallon:                      {"gallon impérial", "gallons impériaux"},
+		Celsius:                             {"degré Celsius", "degrés Celsius"},
+		Fahrenheit:                          {"degré Fahrenheit", "degrés Fahrenheit"},
+		Kelvin:                              {"kelvin", "kelvins"},
+		Rankine:                             {"degré Rankine", "degrés Rankine"},
+		Reaumur:                             {"degré Réaumur", "degrés Réaumur"},
+		Romer:                               {"degré Rømer", "degrés Rømer"},
+		Delisle:                             {"degré Delisle", "degrés Delisle"},
+		Newton:                              {"degré Newton", "degrés Newton"},
+	},
+}
+
+var spanish = Locale{
+	Tag:                   "es",
+	Decimal:               ",",
+	Group:                 ".",
+	MinimumGroupingDigits: 2,
+	Names: map[Unit]UnitName{
+		Kilogram:                            {"kilogramo", "kilogramos"},
+		Gram:                                {"gramo", "gramos"},
+		Pound:                               {"libra", "libras"},
+		Ounce:                               {"onza", "onzas"},
+		Tonne:                               {"tonelada", "toneladas"},
+		Stone:                               {"stone", "stones"},
+		Milligram:                           {"miligramo", "miligramos"},
+		Microgram:                           {"microgramo", "microgramos"},
+		ShortTon:                            {"tonelada corta", "toneladas cortas"},
+		LongTon:                             {"tonelada larga", "toneladas largas"},
+		Grain:                               {"grano", "granos"},
+		Dram:                                {"dracma", "dracmas"},
+		TroyOunce:                           {"onza troy", "onzas troy"},
+		TroyPound:                           {"libra troy", "libras troy"},
+		Pennyweight:                         {"pennyweight", "pennyweights"},
+		Carat:                               {"quilate", "quilates"},
+		AtomicMassUnit:                      {"unidad de masa atómica", "unidades de masa atómica"},
+		Slug:                                {"slug", "slugs"},
+		Torr:                                {"torr", "torr"},
+		Bar:                                 {"bar", "bares"},
+		Pascal:                              {"pascal", "pascales"},
+		PoundForcePerSquareInch:             {"libra-fuerza por pulgada cuadrada", "libras-fuerza por pulgada cuadrada"},
+		PoundForcePerSquareInchGauge:        {"libra-fuerza por pulgada cuadrada manométrica", "libras-fuerza por pulgada cuadrada manométricas"},
+		BarGauge:                            {"bar manométrico", "bares manométricos"},
+		PoundForcePerSquareInchDifferential: {"libra-fuerza por pulgada cuadrada diferencial", "libras-fuerza por pulgada cuadrada diferenciales"},
+		Atmosphere:                          {"atmósfera estándar", "atmósferas estándar"},
+		TechnicalAtmosphere:                 {"atmósfera técnica", "atmósferas técnicas"},
+		MillimetreOfMercury:                 {"milímetro de mercurio", "milímetros de mercurio"},
+		InchOfMercury:                       {"pulgada de mercurio", "pulgadas de mercurio"},
+		CentimetreOfWater:                   {"centímetro de agua", "centímetros de agua"},
+		InchOfWater:                         {"pulgada de agua", "pulgadas de agua"},
+		Millibar:                            {"milibar", "milibares"},
+		Hectopascal:                         {"hectopascal", "hectopascales"},
+		Kilopascal:                          {"kilopascal", "kilopascales"},
+		Megapascal:                          {"megapascal", "megapascales"},
+		KilopoundForcePerSquareInch:         {"kip por pulgada cuadrada", "kips por pulgada cuadrada"},
+		Milliliter:                          {"mililitro", "mililitros"},
+		Litre:                               {"litro", "litros"},
+		USfluidOunce:                        {"onza líquida estadounidense", "onzas líquidas estadounidenses"},
+		USlegalCup:                          {"taza estadounidense", "tazas estadounidenses"},
+		USliquidPint:                        {"pinta estadounidense", "pintas estadounidenses"},
+		USLiquidQuart:                       {"cuarto estadounidense", "cuartos estadounidenses"},
+		USLiquidGallon:                      {"galón estadounidense", "galones estadounidenses"},
+		ImperialFluidOunce:                  {"onza líquida imperial", "onzas líquidas imperiales"},
+		ImperialCup:                         {"taza imperial", "tazas imperiales"},
+		ImperialPint:                        {"pinta imperial", "pintas imperiales"},
+		ImperialQuart:                       {"cuarto imperial", "cuartos imperiales"},
+		ImperialGallon:                      {"galón imperial", "galones imperiales"},
+		Celsius:                             {"grado Celsius", "grados Celsius"},
+		Fahrenheit:                          {"grado Fahrenheit", "grados Fahrenheit"},
+		Kelvin:                              {"kelvin", "kelvins"},
+		Rankine:                             {"grado Rankine", "grados Rankine"},
+		Reaumur:                             {"grado Réaumur", "grados Réaumur"},
+		Romer:                               {"grado Rømer", "grados Rømer"},
+		Delisle:                             {"grado Delisle", "grados Delisle"},
+		Newton:                              {"grado Newton", "grados Newton"},
+	},
+}
+
+var japanese = Locale{
+	Tag:     "ja",
+	Decimal: ".",
+	Group:   ",",
+	PluralRule: func(value float64) bool {
+		return false
+	},
+	Names: map[Unit]UnitName{
+		Kilogram:                            {Singular: "キログラム"},
+		Gram:                                {Singular: "グラム"},
+		Pound:                               {Singular: "ポンド"},
+		Ounce:                               {Singular: "オンス"},
+		Tonne:                               {Singular: "トン"},
+		Stone:                               {Singular: "ストーン"},
+		Milligram:                           {Singular: "ミリグラム"},
+		Microgram:                           {Singular: "マイクログラム"},
+		ShortTon:                            {Singular: "米トン"},
+		LongTon:                             {Singular: "英トン"},
+		Grain:                               {Singular: "グレーン"},
+		Dram:                                {Singular: "ドラム"},
+		TroyOunce:                           {Singular: "トロイオンス"},
+		TroyPound:                           {Singular: "トロイポンド"},
+		Pennyweight:                         {Singular: "ペニーウェイト"},
+		Carat:                               {Singular: "カラット"},
+		AtomicMassUnit:                      {Singular: "原子質量単位"},
+		Slug:                                {Singular: "スラグ"},
+		Torr:                                {Singular: "トル"},
+		Bar:                                 {Singular: "バール"},
+		Pascal:                              {Singular: "パスカル"},
+		PoundForcePerSquareInch:             {Singular: "重量ポンド毎平方インチ"},
+		PoundForcePerSquareInchGauge:        {Singular: "重量ポンド毎平方インチ（ゲージ圧）"},
+		BarGauge:                            {Singular: "バール（ゲージ圧）"},
+		PoundForcePerSquareInchDifferential: {Singular: "重量ポンド毎平方インチ（差圧）"},
+		Atmosphere:                          {Singular: "標準気圧"},
+		TechnicalAtmosphere:                 {Singular: "工学気圧"},
+		MillimetreOfMercury:                 {Singular: "水銀柱ミリメートル"},
+		InchOfMercury:                       {Singular: "水銀柱インチ"},
+		CentimetreOfWater:                   {Singular: "水柱センチメートル"},
+		InchOfWater:                         {Singular: "水柱インチ"},
+		Millibar:                            {Singular: "ミリバール"},
+		Hectopascal:                         {Singular: "ヘクトパスカル"},
+		Kilopascal:                          {Singular: "キロパスカル"},
+		Megapascal:                          {Singular: "メガパスカル"},
+		KilopoundForcePerSquareInch:         {Singular: "キップ毎平方インチ"},
+		Milliliter:                          {Singular: "ミリリットル"},
+		Litre:                               {Singular: "リットル"},
+		USfluidOunce:                        {Singular: "米液量オンス"},
+		USlegalCup:                          {Singular: "米法定カップ"},
+		USliquidPint:                        {Singular: "米液量パイント"},
+		USLiquidQuart:                       {Singular: "米液量クォート"},
+		USLiquidGallon:                      {Singular: "米液量ガロン"},
+		ImperialFluidOunce:                  {Singular: "英液量オンス"},
+		ImperialCup:                         {Singular: "英カップ"},
+		ImperialPint:                        {Singular: "英パイント"},
+		ImperialQuart:                       {Singular: "英クォート"},
+		ImperialGallon:                      {Singular: "英ガロン"},
+		Celsius:                             {Singular: "摂氏度"},
+		Fahrenheit:                          {Singular: "華氏度"},
+		Kelvin:                              {Singular: "ケルビン"},
+		Rankine:                             {Singular: "ランキン度"},
+		Reaumur:                             {Singular: "列氏度"},
+		Romer:                               {Singular: "レーマー度"},
+		Delisle:                             {Singular: "ドリール度"},
+		Newton:                              {Singular: "ニュートン度"},
+	},
+}
@@ -0,0 +1,150 @@
+package measurements_test
+
+import (
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Format_Locale(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		measurement measurements.Measurement
+		opts        measurements.FormatOptions
+		want        string
+	}{
+		{
+			name:        "English grouping",
+			tag:         "en",
+			measurement: measurements.FromKilogram(1234.5),
+			opts:        measurements.FormatOptions{Precision: 2},
+			want:        "1,234.50 kg",
+		},
+		{
+			name:        "German separators",
+			tag:         "de",
+			measurement: measurements.FromKilogram(1234.5),
+			opts:        measurements.FormatOptions{Precision: 2},
+			want:        "1.234,50 kg",
+		},
+		{
+			name:        "German region falls back to language",
+			tag:         "de-AT",
+			measurement: measurements.FromKilogram(-1234567.5),
+			opts:        measurements.FormatOptions{Precision: 1},
+			want:        "-1.234.567,5 kg",
+		},
+		{
+			name:        "German name",
+			tag:         "de_DE",
+			measurement: measurements.FromKilogram(2),
+			opts:        measurements.FormatOptions{Precision: 0, Name: true},
+			want:        "2 Kilogramm",
+		},
+		{
+			name:        "French grouping",
+			tag:         "fr",
+			measurement: measurements.FromPascal(101325),
+			opts:        measurements.FormatOptions{Precision: 0},
+			want:        "101\u202f325 Pa",
+		},
+		{
+			name:        "French singular below two",
+			tag:         "fr",
+			measurement: measurements.FromLiter(1.5),
+			opts:        measurements.FormatOptions{Precision: 1, Name: true},
+			want:        "1,5 litre",
+		},
+		{
+			name:        "French plural",
+			tag:         "fr",
+			measurement: measurements.FromLiter(2.5),
+			opts:        measurements.FormatOptions{Precision: 1, Name: true},
+			want:        "2,5 litres",
+		},
+		{
+			name:        "Spanish minimum grouping",
+			tag:         "es",
+			measurement: measurements.FromGram(1234),
+			opts:        measurements.FormatOptions{Precision: 0},
+			want:        "1234 g",
+		},
+		{
+			name:        "Spanish grouping",
+			tag:         "es",
+			measurement: measurements.FromGram(12345),
+			opts:        measurements.FormatOptions{Precision: 0, Name: true},
+			want:        "12.345 gramos",
+		},
+		{
+			name:        "German pressure added later",
+			tag:         "de",
+			measurement: measurements.FromAtmosphere(2),
+			opts:        measurements.FormatOptions{Precision: 2, Name: true},
+			want:        "2,00 physikalische Atmosphären",
+		},
+		{
+			name:        "Japanese name",
+			tag:         "ja",
+			measurement: measurements.FromCelsius(1234.5),
+			opts:        measurements.FormatOptions{Precision: 1, Name: true},
+			want:        "1,234.5 摂氏度",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale, ok := measurements.LookupLocale(tt.tag)
+			if !ok {
+				t.Fatalf("LookupLocale(%q) not found", tt.tag)
+			}
+			tt.opts.Locale = locale
+			if got := measurements.Format(tt.measurement, tt.opts); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Locale_Names(t *testing.T) {
+	for _, tag := range []string{"de", "fr", "es", "ja"} {
+		locale, ok := measurements.LookupLocale(tag)
+		if !ok {
+			t.Fatalf("LookupLocale(%q) not found", tag)
+		}
+		for kind, units := range measurements.BuiltinUnits {
+			for _, info := range units {
+				if name, ok := locale.Names[info.Unit]; !ok || name.Singular == "" {
+					t.Errorf("%s has no name for %s %s", tag, kind, info.Symbol)
+				}
+			}
+		}
+	}
+}
+
+func Test_RegisterLocale(t *testing.T) {
+	measurements.RegisterLocale(measurements.Locale{
+		Tag:     "nl",
+		Decimal: ",",
+		Group:   ".",
+		Names: map[measurements.Unit]measurements.UnitName{
+			measurements.Litre: {Singular: "liter", Plural: "liter"},
+		},
+	})
+
+	locale, ok := measurements.LookupLocale("nl-BE")
+	if !ok {
+		t.Fatal("LookupLocale() not found")
+	}
+
+	opts := measurements.FormatOptions{Precision: 2, Name: true, Locale: locale}
+	if got, want := measurements.Format(measurements.FromLiter(1500), opts), "1.500,00 liter"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+	if got, want := measurements.Format(measurements.FromKilogram(3), opts), "3,00 kilograms"; got != want {
+		t.Errorf("Format() = %v, want %v", got, want)
+	}
+	if _, ok := measurements.LookupLocale("xx"); ok {
+		t.Error("LookupLocale() found an unregistered locale")
+	}
+}
@@ -0,0 +1,364 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements
+
+type MassUnit int32
+
+const (
+	Kilogram MassUnit = iota
+	Gram
+	Pound
+	Ounce
+	Tonne
+	Stone
+	Milligram
+	Microgram
+	ShortTon
+	LongTon
+	Grain
+	Dram
+	TroyOunce
+	TroyPound
+	Pennyweight
+	Carat
+	AtomicMassUnit
+	Slug
+)
+
+// InvalidMassUnit is returned by RegisterMassUnit on error. It is
+// never a mass unit, so IsValid reports false and ConvertTo and the
+// encodings reject it.
+const InvalidMassUnit MassUnit = -1
+
+// MassUnitName and MassUnitValue hold the built-in mass units only; units
+// added by RegisterMassUnit are found through LookupUnit.
+var MassUnitName = map[MassUnit]string{
+	Kilogram:       "kg",
+	Gram:           "g",
+	Pound:          "lb",
+	Ounce:          "oz",
+	Tonne:          "t",
+	Stone:          "st",
+	Milligram:      "mg",
+	Microgram:      "µg",
+	ShortTon:       "sh tn",
+	LongTon:        "long tn",
+	Grain:          "gr",
+	Dram:           "dr",
+	TroyOunce:      "oz t",
+	TroyPound:      "lb t",
+	Pennyweight:    "dwt",
+	Carat:          "ct",
+	AtomicMassUnit: "u",
+	Slug:           "slug",
+}
+
+var MassUnitValue = map[string]MassUnit{
+	"kg":      Kilogram,
+	"g":       Gram,
+	"lb":      Pound,
+	"oz":      Ounce,
+	"t":       Tonne,
+	"st":      Stone,
+	"mg":      Milligram,
+	"µg":      Microgram,
+	"sh tn":   ShortTon,
+	"long tn": LongTon,
+	"gr":      Grain,
+	"dr":      Dram,
+	"oz t":    TroyOunce,
+	"lb t":    TroyPound,
+	"dwt":     Pennyweight,
+	"ct":      Carat,
+	"u":       AtomicMassUnit,
+	"slug":    Slug,
+}
+var massUnits = []UnitInfo{
+	{Unit: Kilogram, Kind: MassKind, Symbol: "kg", Singular: "kilogram", Plural: "kilograms", System: SI, Base: true, Factor: 1, name: "kg", goName: "Kilogram"},
+	{Unit: Gram, Kind: MassKind, Symbol: "g", Singular: "gram", Plural: "grams", System: SI, Prefixable: true, Factor: 0.001, name: "g", goName: "Gram"},
+	{Unit: Pound, Kind: MassKind, Symbol: "lb", Singular: "pound", Plural: "pounds", System: USCustomary | Imperial, Factor: 0.45359237, name: "lb", goName: "Pound"},
+	{Unit: Ounce, Kind: MassKind, Symbol: "oz", Singular: "ounce", Plural: "ounces", System: USCustomary | Imperial, Factor: 0.028349523125, name: "oz", goName: "Ounce"},
+	{Unit: Tonne, Kind: MassKind, Symbol: "t", Singular: "tonne", Plural: "tonnes", System: SI, Factor: 1000, name: "t", goName: "Tonne"},
+	{Unit: Stone, Kind: MassKind, Symbol: "st", Singular: "stone", Plural: "stone", System: Imperial, Factor: 6.35029318, name: "st", goName: "Stone"},
+	{Unit: Milligram, Kind: MassKind, Symbol: "mg", Singular: "milligram", Plural: "milligrams", System: SI, Factor: 0.000001, name: "mg", goName: "Milligram"},
+	{Unit: Microgram, Kind: MassKind, Symbol: "µg", Singular: "microgram", Plural: "micrograms", System: SI, Factor: 0.000000001, name: "µg", aliases: []string{"μg", "ug", "mcg"}, goName: "Microgram"},
+	{Unit: ShortTon, Kind: MassKind, Symbol: "sh tn", Singular: "short ton", Plural: "short tons", System: USCustomary, Factor: 907.18474, name: "sh tn", goName: "ShortTon"},
+	{Unit: LongTon, Kind: MassKind, Symbol: "long tn", Singular: "long ton", Plural: "long tons", System: Imperial, Factor: 1016.0469088, name: "long tn", goName: "LongTon"},
+	{Unit: Grain, Kind: MassKind, Symbol: "gr", Singular: "grain", Plural: "grains", System: USCustomary | Imperial, Factor: 0.00006479891, name: "gr", goName: "Grain"},
+	{Unit: Dram, Kind: MassKind, Symbol: "dr", Singular: "dram", Plural: "drams", System: USCustomary | Imperial, Factor: 0.0017718451953125, name: "dr", goName: "Dram"},
+	{Unit: TroyOunce, Kind: MassKind, Symbol: "oz t", Singular: "troy ounce", Plural: "troy ounces", Factor: 0.0311034768, name: "oz t", aliases: []string{"ozt"}, goName: "TroyOunce"},
+	{Unit: TroyPound, Kind: MassKind, Symbol: "lb t", Singular: "troy pound", Plural: "troy pounds", Factor: 0.3732417216, name: "lb t", goName: "TroyPound"},
+	{Unit: Pennyweight, Kind: MassKind, Symbol: "dwt", Singular: "pennyweight", Plural: "pennyweights", Factor: 0.00155517384, name: "dwt", goName: "Pennyweight"},
+	{Unit: Carat, Kind: MassKind, Symbol: "ct", Singular: "carat", Plural: "carats", Factor: 0.0002, name: "ct", goName: "Carat"},
+	{Unit: AtomicMassUnit, Kind: MassKind, Symbol: "u", Singular: "atomic mass unit", Plural: "atomic mass units", Factor: 1.66053906660e-27, name: "u", aliases: []string{"Da", "amu"}, goName: "AtomicMassUnit"},
+	{Unit: Slug, Kind: MassKind, Symbol: "slug", Singular: "slug", Plural: "slugs", Factor: 0.45359237 * 9.80665 / 0.3048, name: "slug", goName: "Slug"},
+}
+
+func (s MassUnit) Kind() Kind {
+	return MassKind
+}
+
+func (s MassUnit) String() string {
+	return unitInfo(s).name
+}
+
+func (s MassUnit) Symbol() string {
+	return s.Info().Symbol
+}
+
+func (s MassUnit) Singular() string {
+	return s.Info().Singular
+}
+
+func (s MassUnit) Plural() string {
+	return s.Info().Plural
+}
+
+func (s MassUnit) Info() UnitInfo {
+	return unitInfo(s)
+}
+
+func (s MassUnit) GoString() string {
+	return unitGoString(s, "MassUnit", int32(s))
+}
+
+// IsValid reports whether s is a built-in or registered mass unit.
+func (s MassUnit) IsValid() bool {
+	_, ok := lookupUnitInfo(MassKind, s)
+	return ok
+}
+
+type Mass interface {
+	Measurement
+
+	Unit() MassUnit
+	Value() float64
+	String() string
+
+	Validate() error
+
+	To(unit MassUnit) Mass
+	ConvertTo(unit MassUnit) (Mass, error)
+	ToKilogram() Mass
+	ToGram() Mass
+	ToPound() Mass
+	ToOunce() Mass
+	ToTonne() Mass
+	ToStone() Mass
+	ToMilligram() Mass
+	ToMicrogram() Mass
+	ToShortTon() Mass
+	ToLongTon() Mass
+	ToGrain() Mass
+	ToDram() Mass
+	ToTroyOunce() Mass
+	ToTroyPound() Mass
+	ToPennyweight() Mass
+	ToCarat() Mass
+	ToAtomicMassUnit() Mass
+	ToSlug() Mass
+}
+
+type mass struct {
+	Quantity[MassUnit]
+}
+
+// RegisterMassUnit adds a mass unit at run time. The unit works with
+// To, parsing and formatting like the built-in units, but its enum value
+// depends on the order of registration, so it cannot be written by
+// MarshalBinary or an Encoder. On error it returns InvalidMassUnit.
+func RegisterMassUnit(def UnitDefinition) (MassUnit, error) {
+	info, err := registerUnit(MassKind, def)
+	if err != nil {
+		return InvalidMassUnit, err
+	}
+	return info.Unit.(MassUnit), nil
+}
+
+func NewMass(unit MassUnit, value float64) Mass {
+	return &mass{NewQuantity(unit, value)}
+}
+
+// NewMassChecked is NewMass for untrusted values: it returns a
+// *ValueError when value is NaN, infinite or physically impossible.
+func NewMassChecked(unit MassUnit, value float64) (Mass, error) {
+	m := NewMass(unit, value)
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func FromKilogram(value float64) Mass {
+	return NewMass(Kilogram, value)
+}
+
+func FromGram(value float64) Mass {
+	return NewMass(Gram, value)
+}
+
+func FromPound(value float64) Mass {
+	return NewMass(Pound, value)
+}
+
+func FromOunce(value float64) Mass {
+	return NewMass(Ounce, value)
+}
+
+func FromTonne(value float64) Mass {
+	return NewMass(Tonne, value)
+}
+
+func FromStone(value float64) Mass {
+	return NewMass(Stone, value)
+}
+
+func FromMilligram(value float64) Mass {
+	return NewMass(Milligram, value)
+}
+
+func FromMicrogram(value float64) Mass {
+	return NewMass(Microgram, value)
+}
+
+func FromShortTon(value float64) Mass {
+	return NewMass(ShortTon, value)
+}
+
+func FromLongTon(value float64) Mass {
+	return NewMass(LongTon, value)
+}
+
+func FromGrain(value float64) Mass {
+	return NewMass(Grain, value)
+}
+
+func FromDram(value float64) Mass {
+	return NewMass(Dram, value)
+}
+
+func FromTroyOunce(value float64) Mass {
+	return NewMass(TroyOunce, value)
+}
+
+func FromTroyPound(value float64) Mass {
+	return NewMass(TroyPound, value)
+}
+
+func FromPennyweight(value float64) Mass {
+	return NewMass(Pennyweight, value)
+}
+
+func FromCarat(value float64) Mass {
+	return NewMass(Carat, value)
+}
+
+func FromAtomicMassUnit(value float64) Mass {
+	return NewMass(AtomicMassUnit, value)
+}
+
+func FromSlug(value float64) Mass {
+	return NewMass(Slug, value)
+}
+
+func ParseMass(s string) (Mass, error) {
+	value, info, err := parseMeasurement(MassKind, s)
+	if err != nil {
+		return nil, err
+	}
+	return NewMass(info.Unit.(MassUnit), value), nil
+}
+
+// BestMassUnit returns the unit that shows m most readably, as chosen
+// by BestUnit.
+func BestMassUnit(m Mass, opts HumanizeOptions) MassUnit {
+	return BestUnit(m, opts).(MassUnit)
+}
+
+func (s *mass) To(unit MassUnit) Mass {
+	return &mass{s.Quantity.To(unit)}
+}
+
+func (s *mass) convertedTo(unit Unit, value float64) Measurement {
+	return NewMass(unit.(MassUnit), value)
+}
+
+func (s *mass) ConvertTo(unit MassUnit) (Mass, error) {
+	q, err := s.Quantity.ConvertTo(unit)
+	if err != nil {
+		return nil, err
+	}
+	return &mass{q}, nil
+}
+
+func (s *mass) ToKilogram() Mass {
+	return s.To(Kilogram)
+}
+
+func (s *mass) ToGram() Mass {
+	return s.To(Gram)
+}
+
+func (s *mass) ToPound() Mass {
+	return s.To(Pound)
+}
+
+func (s *mass) ToOunce() Mass {
+	return s.To(Ounce)
+}
+
+func (s *mass) ToTonne() Mass {
+	return s.To(Tonne)
+}
+
+func (s *mass) ToStone() Mass {
+	return s.To(Stone)
+}
+
+func (s *mass) ToMilligram() Mass {
+	return s.To(Milligram)
+}
+
+func (s *mass) ToMicrogram() Mass {
+	return s.To(Microgram)
+}
+
+func (s *mass) ToShortTon() Mass {
+	return s.To(ShortTon)
+}
+
+func (s *mass) ToLongTon() Mass {
+	return s.To(LongTon)
+}
+
+func (s *mass) ToGrain() Mass {
+	return s.To(Grain)
+}
+
+func (s *mass) ToDram() Mass {
+	return s.To(Dram)
+}
+
+func (s *mass) ToTroyOunce() Mass {
+	return s.To(TroyOunce)
+}
+
+func (s *mass) ToTroyPound() Mass {
+	return s.To(TroyPound)
+}
+
+func (s *mass) ToPennyweight() Mass {
+	return s.To(Pennyweight)
+}
+
+func (s *mass) ToCarat() Mass {
+	return s.To(Carat)
+}
+
+func (s *mass) ToAtomicMassUnit() Mass {
+	return s.To(AtomicMassUnit)
+}
+
+func (s *mass) ToSlug() Mass {
+	return s.To(Slug)
+}
@@ -0,0 +1,128 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_mass_generated(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.MassUnit
+		// base is one of unit in kilograms.
+		base float64
+	}{
+		{
+			name: "Kilogram",
+			unit: measurements.Kilogram,
+			base: 1,
+		},
+		{
+			name: "Gram",
+			unit: measurements.Gram,
+			base: 0.001,
+		},
+		{
+			name: "Pound",
+			unit: measurements.Pound,
+			base: 0.45359237,
+		},
+		{
+			name: "Ounce",
+			unit: measurements.Ounce,
+			base: 0.028349523125,
+		},
+		{
+			name: "Tonne",
+			unit: measurements.Tonne,
+			base: 1000,
+		},
+		{
+			name: "Stone",
+			unit: measurements.Stone,
+			base: 6.35029318,
+		},
+		{
+			name: "Milligram",
+			unit: measurements.Milligram,
+			base: 0.000001,
+		},
+		{
+			name: "Microgram",
+			unit: measurements.Microgram,
+			base: 0.000000001,
+		},
+		{
+			name: "ShortTon",
+			unit: measurements.ShortTon,
+			base: 907.18474,
+		},
+		{
+			name: "LongTon",
+			unit: measurements.LongTon,
+			base: 1016.0469088,
+		},
+		{
+			name: "Grain",
+			unit: measurements.Grain,
+			base: 0.00006479891,
+		},
+		{
+			name: "Dram",
+			unit: measurements.Dram,
+			base: 0.0017718451953125,
+		},
+		{
+			name: "TroyOunce",
+			unit: measurements.TroyOunce,
+			base: 0.0311034768,
+		},
+		{
+			name: "TroyPound",
+			unit: measurements.TroyPound,
+			base: 0.3732417216,
+		},
+		{
+			name: "Pennyweight",
+			unit: measurements.Pennyweight,
+			base: 0.00155517384,
+		},
+		{
+			name: "Carat",
+			unit: measurements.Carat,
+			base: 0.0002,
+		},
+		{
+			name: "AtomicMassUnit",
+			unit: measurements.AtomicMassUnit,
+			base: 1.66053906660e-27,
+		},
+		{
+			name: "Slug",
+			unit: measurements.Slug,
+			base: 0.45359237 * 9.80665 / 0.3048,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			one := measurements.NewMass(tt.unit, 1)
+			if got, want := one.ToKilogram(), measurements.FromKilogram(tt.base); !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("ToKilogram() = %v, want %v", got, want)
+			}
+			if got := one.ToKilogram().To(tt.unit); !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ToKilogram().To() = %v, want %v", got, one)
+			}
+			got, err := measurements.ParseMass(one.String())
+			if err != nil {
+				t.Fatalf("ParseMass() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ParseMass() = %v, want %v", got, one)
+			}
+		})
+	}
+}
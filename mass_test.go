@@ -92,7 +92,7 @@ func Test_mass_ToGram(t *testing.T) {
 				unit:  measurements.Pound,
 				value: 0.0220462,
 			},
-			want: measurements.FromGram(10.01),
+			want: measurements.FromGram(10),
 		},
 		{
 			name: "Ounce to Gram",
@@ -137,7 +137,7 @@ func Test_mass_ToPound(t *testing.T) {
 				unit:  measurements.Gram,
 				value: 4535.92,
 			},
-			want: measurements.FromPound(9.99),
+			want: measurements.FromPound(10),
 		},
 		{
 			name: "Pound to Pound",
@@ -218,3 +218,67 @@ func Test_mass_ToOunce(t *testing.T) {
 		})
 	}
 }
+
+func Test_mass_extendedUnits(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurements.Mass
+		unit measurements.MassUnit
+		want string
+	}{
+		{name: "Short ton to Pound", m: measurements.FromShortTon(1), unit: measurements.Pound, want: "2000.00 lb"},
+		{name: "Long ton to Pound", m: measurements.FromLongTon(1), unit: measurements.Pound, want: "2240.00 lb"},
+		{name: "Tonne to Kilogram", m: measurements.FromTonne(1.5), unit: measurements.Kilogram, want: "1500.00 kg"},
+		{name: "Stone to Pound", m: measurements.FromStone(1), unit: measurements.Pound, want: "14.00 lb"},
+		{name: "Grain to Pound", m: measurements.FromGrain(7000), unit: measurements.Pound, want: "1.00 lb"},
+		{name: "Dram to Ounce", m: measurements.FromDram(16), unit: measurements.Ounce, want: "1.00 oz"},
+		{name: "Grain to Troy ounce", m: measurements.FromGrain(480), unit: measurements.TroyOunce, want: "1.00 oz t"},
+		{name: "Troy ounce to Gram", m: measurements.FromTroyOunce(1), unit: measurements.Gram, want: "31.10 g"},
+		{name: "Troy pound to Troy ounce", m: measurements.FromTroyPound(1), unit: measurements.TroyOunce, want: "12.00 oz t"},
+		{name: "Pennyweight to Grain", m: measurements.FromPennyweight(1), unit: measurements.Grain, want: "24.00 gr"},
+		{name: "Carat to Milligram", m: measurements.FromCarat(1), unit: measurements.Milligram, want: "200.00 mg"},
+		{name: "Milligram to Microgram", m: measurements.FromMilligram(0.5), unit: measurements.Microgram, want: "500.00 µg"},
+		{name: "Carbon-12 atom to Atomic mass unit", m: measurements.FromKilogram(1.99264687992e-26), unit: measurements.AtomicMassUnit, want: "12.00 u"},
+		{name: "Slug to Kilogram", m: measurements.FromSlug(1), unit: measurements.Kilogram, want: "14.59 kg"},
+		{name: "Slug to Pound", m: measurements.FromSlug(1), unit: measurements.Pound, want: "32.17 lb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.To(tt.unit); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("To() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParseMass_extendedUnits(t *testing.T) {
+	tests := []struct {
+		input string
+		want  measurements.MassUnit
+	}{
+		{input: "250 mg", want: measurements.Milligram},
+		{input: "50 mcg", want: measurements.Microgram},
+		{input: "50 μg", want: measurements.Microgram},
+		{input: "2 sh tn", want: measurements.ShortTon},
+		{input: "2 long tons", want: measurements.LongTon},
+		{input: "5 gr", want: measurements.Grain},
+		{input: "3 dr", want: measurements.Dram},
+		{input: "1 oz t", want: measurements.TroyOunce},
+		{input: "1 lb t", want: measurements.TroyPound},
+		{input: "18 dwt", want: measurements.Pennyweight},
+		{input: "1.5 ct", want: measurements.Carat},
+		{input: "12 Da", want: measurements.AtomicMassUnit},
+		{input: "3 slugs", want: measurements.Slug},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := measurements.ParseMass(tt.input)
+			if err != nil {
+				t.Fatalf("ParseMass() error = %v", err)
+			}
+			if got.Unit() != tt.want {
+				t.Errorf("ParseMass() unit = %v, want %v", got.Unit(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Package measurementspb holds the Protocol Buffers messages for the
+// measurements package and converts between the two.
+package measurementspb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative measurements.proto
+
+import (
+	"fmt"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+var massUnits = map[measurements.MassUnit]MassUnit{
+	measurements.Kilogram:       MassUnit_MASS_UNIT_KILOGRAM,
+	measurements.Gram:           MassUnit_MASS_UNIT_GRAM,
+	measurements.Pound:          MassUnit_MASS_UNIT_POUND,
+	measurements.Ounce:          MassUnit_MASS_UNIT_OUNCE,
+	measurements.Tonne:          MassUnit_MASS_UNIT_TONNE,
+	measurements.Stone:          MassUnit_MASS_UNIT_STONE,
+	measurements.Milligram:      MassUnit_MASS_UNIT_MILLIGRAM,
+	measurements.Microgram:      MassUnit_MASS_UNIT_MICROGRAM,
+	measurements.ShortTon:       MassUnit_MASS_UNIT_SHORT_TON,
+	measurements.LongTon:        MassUnit_MASS_UNIT_LONG_TON,
+	measurements.Grain:          MassUnit_MASS_UNIT_GRAIN,
+	measurements.Dram:           MassUnit_MASS_UNIT_DRAM,
+	measurements.TroyOunce:      MassUnit_MASS_UNIT_TROY_OUNCE,
+	measurements.TroyPound:      MassUnit_MASS_UNIT_TROY_POUND,
+	measurements.Pennyweight:    MassUnit_MASS_UNIT_PENNYWEIGHT,
+	measurements.Carat:          MassUnit_MASS_UNIT_CARAT,
+	measurements.AtomicMassUnit: MassUnit_MASS_UNIT_ATOMIC_MASS_UNIT,
+	measurements.Slug:           MassUnit_MASS_UNIT_SLUG,
+}
+
+var pressureUnits = map[measurements.PressureUnit]PressureUnit{
+	measurements.Torr:                                PressureUnit_PRESSURE_UNIT_TORR,
+	measurements.Bar:                                 PressureUnit_PRESSURE_UNIT_BAR,
+	measurements.Pascal:                              PressureUnit_PRESSURE_UNIT_PASCAL,
+	measurements.PoundForcePerSquareInch:             PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH,
+	measurements.PoundForcePerSquareInchGauge:        PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_GAUGE,
+	measurements.BarGauge:                            PressureUnit_PRESSURE_UNIT_BAR_GAUGE,
+	measurements.PoundForcePerSquareInchDifferential: PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_DIFFERENTIAL,
+	measurements.Atmosphere:                          PressureUnit_PRESSURE_UNIT_ATMOSPHERE,
+	measurements.TechnicalAtmosphere:                 PressureUnit_PRESSURE_UNIT_TECHNICAL_ATMOSPHERE,
+	measurements.MillimetreOfMercury:                 PressureUnit_PRESSURE_UNIT_MILLIMETRE_OF_MERCURY,
+	measurements.InchOfMercury:                       PressureUnit_PRESSURE_UNIT_INCH_OF_MERCURY,
+	measurements.CentimetreOfWater:                   PressureUnit_PRESSURE_UNIT_CENTIMETRE_OF_WATER,
+	measurements.InchOfWater:                         PressureUnit_PRESSURE_UNIT_INCH_OF_WATER,
+	measurements.Millibar:                            PressureUnit_PRESSURE_UNIT_MILLIBAR,
+	measurements.Hectopascal:                         PressureUnit_PRESSURE_UNIT_HECTOPASCAL,
+	measurements.Kilopascal:                          PressureUnit_PRESSURE_UNIT_KILOPASCAL,
+	measurements.Megapascal:                          PressureUnit_PRESSURE_UNIT_MEGAPASCAL,
+	measurements.KilopoundForcePerSquareInch:         PressureUnit_PRESSURE_UNIT_KILOPOUND_FORCE_PER_SQUARE_INCH,
+}
+
+var volumeUnits = map[measurements.VolumeType]VolumeUnit{
+	measurements.Milliliter:         VolumeUnit_VOLUME_UNIT_MILLILITER,
+	measurements.Litre:              VolumeUnit_VOLUME_UNIT_LITRE,
+	measurements.USfluidOunce:       VolumeUnit_VOLUME_UNIT_US_FLUID_OUNCE,
+	measurements.USlegalCup:         VolumeUnit_VOLUME_UNIT_US_LEGAL_CUP,
+	measurements.USliquidPint:       VolumeUnit_VOLUME_UNIT_US_LIQUID_PINT,
+	measurements.USLiquidQuart:      VolumeUnit_VOLUME_UNIT_US_LIQUID_QUART,
+	measurements.USLiquidGallon:     VolumeUnit_VOLUME_UNIT_US_LIQUID_GALLON,
+	measurements.ImperialFluidOunce: VolumeUnit_VOLUME_UNIT_IMPERIAL_FLUID_OUNCE,
+	measurements.ImperialCup:        VolumeUnit_VOLUME_UNIT_IMPERIAL_CUP,
+	measurements.ImperialPint:       VolumeUnit_VOLUME_UNIT_IMPERIAL_PINT,
+	measurements.ImperialQuart:      VolumeUnit_VOLUME_UNIT_IMPERIAL_QUART,
+	measurements.ImperialGallon:     VolumeUnit_VOLUME_UNIT_IMPERIAL_GALLON,
+}
+
+var temperatureUnits = map[measurements.TemperatureUnit]TemperatureUnit{
+	measurements.Celsius:    TemperatureUnit_TEMPERATURE_UNIT_CELSIUS,
+	measurements.Fahrenheit: TemperatureUnit_TEMPERATURE_UNIT_FAHRENHEIT,
+	measurements.Kelvin:     TemperatureUnit_TEMPERATURE_UNIT_KELVIN,
+	measurements.Rankine:    TemperatureUnit_TEMPERATURE_UNIT_RANKINE,
+	measurements.Reaumur:    TemperatureUnit_TEMPERATURE_UNIT_REAUMUR,
+	measurements.Romer:      TemperatureUnit_TEMPERATURE_UNIT_ROMER,
+	measurements.Delisle:    TemperatureUnit_TEMPERATURE_UNIT_DELISLE,
+	measurements.Newton:     TemperatureUnit_TEMPERATURE_UNIT_NEWTON,
+}
+
+func FromMass(m measurements.Mass) (*Mass, error) {
+	unit, ok := massUnits[m.Unit()]
+	if !ok {
+		return nil, fmt.Errorf("%w: mass %#v", measurements.ErrUnknownUnit, m.Unit())
+	}
+	return &Mass{Value: m.Value(), Unit: unit}, nil
+}
+
+func ToMass(m *Mass) (measurements.Mass, error) {
+	for unit, pb := range massUnits {
+		if pb == m.GetUnit() {
+			return measurements.NewMass(unit, m.GetValue()), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: mass %v", measurements.ErrUnknownUnit, m.GetUnit())
+}
+
+func FromPressure(p measurements.Pressure) (*Pressure, error) {
+	unit, ok := pressureUnits[p.Unit()]
+	if !ok {
+		return nil, fmt.Errorf("%w: pressure %#v", measurements.ErrUnknownUnit, p.Unit())
+	}
+	return &Pressure{Value: p.Value(), Unit: unit}, nil
+}
+
+func ToPressure(p *Pressure) (measurements.Pressure, error) {
+	for unit, pb := range pressureUnits {
+		if pb == p.GetUnit() {
+			return measurements.NewPressure(unit, p.GetValue()), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: pressure %v", measurements.ErrUnknownUnit, p.GetUnit())
+}
+
+func FromVolume(v measurements.Volume) (*Volume, error) {
+	unit, ok := volumeUnits[v.Unit()]
+	if !ok {
+		return nil, fmt.Errorf("%w: volume %#v", measurements.ErrUnknownUnit, v.Unit())
+	}
+	return &Volume{Value: v.Value(), Unit: unit}, nil
+}
+
+func ToVolume(v *Volume) (measurements.Volume, error) {
+	for unit, pb := range volumeUnits {
+		if pb == v.GetUnit() {
+			return measurements.NewVolume(unit, v.GetValue()), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: volume %v", measurements.ErrUnknownUnit, v.GetUnit())
+}
+
+func FromTemperature(t measurements.Temperature) (*Temperature, error) {
+	unit, ok := temperatureUnits[t.Unit()]
+	if !ok {
+		return nil, fmt.Errorf("%w: temperature %#v", measurements.ErrUnknownUnit, t.Unit())
+	}
+	return &Temperature{Value: t.Value(), Unit: unit}, nil
+}
+
+func ToTemperature(t *Temperature) (measurements.Temperature, error) {
+	for unit, pb := range temperatureUnits {
+		if pb == t.GetUnit() {
+			return measurements.NewTemperature(unit, t.GetValue()), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: temperature %v", measurements.ErrUnknownUnit, t.GetUnit())
+}
@@ -0,0 +1,157 @@
+package measurementspb_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+	"github.com/RossMerr/go-measurements/measurementspb"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_Mass_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		mass measurements.Mass
+		want measurementspb.MassUnit
+	}{
+		{name: "Kilogram", mass: measurements.FromKilogram(1.5), want: measurementspb.MassUnit_MASS_UNIT_KILOGRAM},
+		{name: "Ounce", mass: measurements.FromOunce(3), want: measurementspb.MassUnit_MASS_UNIT_OUNCE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb, err := measurementspb.FromMass(tt.mass)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pb.GetUnit() != tt.want || pb.GetValue() != tt.mass.Value() {
+				t.Errorf("FromMass() = %v, want unit %v", pb, tt.want)
+			}
+
+			data, err := proto.Marshal(pb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := &measurementspb.Mass{}
+			if err := proto.Unmarshal(data, decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := measurementspb.ToMass(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Unit() != tt.mass.Unit() || got.Value() != tt.mass.Value() {
+				t.Errorf("ToMass() = %v, want %v", got, tt.mass)
+			}
+		})
+	}
+}
+
+func Test_Pressure_RoundTrip(t *testing.T) {
+	pb, err := measurementspb.FromPressure(measurements.FromPoundForcePerSquareInch(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.GetUnit() != measurementspb.PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH {
+		t.Errorf("FromPressure() unit = %v", pb.GetUnit())
+	}
+	got, err := measurementspb.ToPressure(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "32.00 psi" {
+		t.Errorf("ToPressure() = %v, want 32.00 psi", got)
+	}
+}
+
+func Test_Volume_RoundTrip(t *testing.T) {
+	pb, err := measurementspb.FromVolume(measurements.FromImperialPint(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.GetUnit() != measurementspb.VolumeUnit_VOLUME_UNIT_IMPERIAL_PINT {
+		t.Errorf("FromVolume() unit = %v", pb.GetUnit())
+	}
+	got, err := measurementspb.ToVolume(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "1.00 imp pt" {
+		t.Errorf("ToVolume() = %v, want 1.00 imp pt", got)
+	}
+}
+
+func Test_Temperature_RoundTrip(t *testing.T) {
+	pb, err := measurementspb.FromTemperature(measurements.FromKelvin(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.GetUnit() != measurementspb.TemperatureUnit_TEMPERATURE_UNIT_KELVIN {
+		t.Errorf("FromTemperature() unit = %v", pb.GetUnit())
+	}
+	got, err := measurementspb.ToTemperature(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "0.00 K" {
+		t.Errorf("ToTemperature() = %v, want 0.00 K", got)
+	}
+}
+
+func Test_UnknownUnit(t *testing.T) {
+	if _, err := measurementspb.ToMass(&measurementspb.Mass{Value: 1}); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("ToMass() unspecified unit error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+	if _, err := measurementspb.FromMass(measurements.NewMass(99, 1)); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("FromMass() unknown unit error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
+
+// Every built-in unit needs an enum value in measurements.proto. Units
+// registered at run time have none, so the test skips them as the binary
+// encoding does.
+func Test_Units_Mapped(t *testing.T) {
+	tests := []struct {
+		name string
+		kind measurements.Kind
+		from func(unit measurements.Unit) (builtin bool, err error)
+	}{
+		{name: "Mass", kind: measurements.MassKind, from: func(unit measurements.Unit) (bool, error) {
+			m := measurements.NewMass(unit.(measurements.MassUnit), 1)
+			_, err := measurementspb.FromMass(m)
+			return isBuiltin(measurements.MassValue{Mass: m}), err
+		}},
+		{name: "Pressure", kind: measurements.PressureKind, from: func(unit measurements.Unit) (bool, error) {
+			p := measurements.NewPressure(unit.(measurements.PressureUnit), 1)
+			_, err := measurementspb.FromPressure(p)
+			return isBuiltin(measurements.PressureValue{Pressure: p}), err
+		}},
+		{name: "Volume", kind: measurements.VolumeKind, from: func(unit measurements.Unit) (bool, error) {
+			v := measurements.NewVolume(unit.(measurements.VolumeType), 1)
+			_, err := measurementspb.FromVolume(v)
+			return isBuiltin(measurements.VolumeValue{Volume: v}), err
+		}},
+		{name: "Temperature", kind: measurements.TemperatureKind, from: func(unit measurements.Unit) (bool, error) {
+			m := measurements.NewTemperature(unit.(measurements.TemperatureUnit), 1)
+			_, err := measurementspb.FromTemperature(m)
+			return isBuiltin(measurements.TemperatureValue{Temperature: m}), err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, info := range measurements.Units(tt.kind) {
+				if builtin, err := tt.from(info.Unit); builtin && err != nil {
+					t.Errorf("%s has no proto unit: %v", info.Symbol, err)
+				}
+			}
+		})
+	}
+}
+
+// isBuiltin reports whether the binary encoding, which only writes built-in
+// units, accepts m.
+func isBuiltin(m interface{ MarshalBinary() ([]byte, error) }) bool {
+	_, err := m.MarshalBinary()
+	return err == nil
+}
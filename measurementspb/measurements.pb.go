@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: measurements.proto
+
+package measurementspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MassUnit mirrors measurements.MassUnit.
+type MassUnit int32
+
+const (
+	MassUnit_MASS_UNIT_UNSPECIFIED      MassUnit = 0
+	MassUnit_MASS_UNIT_KILOGRAM         MassUnit = 1
+	MassUnit_MASS_UNIT_GRAM             MassUnit = 2
+	MassUnit_MASS_UNIT_POUND            MassUnit = 3
+	MassUnit_MASS_UNIT_OUNCE            MassUnit = 4
+	MassUnit_MASS_UNIT_TONNE            MassUnit = 5
+	MassUnit_MASS_UNIT_STONE            MassUnit = 6
+	MassUnit_MASS_UNIT_MILLIGRAM        MassUnit = 7
+	MassUnit_MASS_UNIT_MICROGRAM        MassUnit = 8
+	MassUnit_MASS_UNIT_SHORT_TON        MassUnit = 9
+	MassUnit_MASS_UNIT_LONG_TON         MassUnit = 10
+	MassUnit_MASS_UNIT_GRAIN            MassUnit = 11
+	MassUnit_MASS_UNIT_DRAM             MassUnit = 12
+	MassUnit_MASS_UNIT_TROY_OUNCE       MassUnit = 13
+	MassUnit_MASS_UNIT_TROY_POUND       MassUnit = 14
+	MassUnit_MASS_UNIT_PENNYWEIGHT      MassUnit = 15
+	MassUnit_MASS_UNIT_CARAT            MassUnit = 16
+	MassUnit_MASS_UNIT_ATOMIC_MASS_UNIT MassUnit = 17
+	MassUnit_MASS_UNIT_SLUG             MassUnit = 18
+)
+
+// Enum value maps for MassUnit.
+var (
+	MassUnit_name = map[int32]string{
+		0:  "MASS_UNIT_UNSPECIFIED",
+		1:  "MASS_UNIT_KILOGRAM",
+		2:  "MASS_UNIT_GRAM",
+		3:  "MASS_UNIT_POUND",
+		4:  "MASS_UNIT_OUNCE",
+		5:  "MASS_UNIT_TONNE",
+		6:  "MASS_UNIT_STONE",
+		7:  "MASS_UNIT_MILLIGRAM",
+		8:  "MASS_UNIT_MICROGRAM",
+		9:  "MASS_UNIT_SHORT_TON",
+		10: "MASS_UNIT_LONG_TON",
+		11: "MASS_UNIT_GRAIN",
+		12: "MASS_UNIT_DRAM",
+		13: "MASS_UNIT_TROY_OUNCE",
+		14: "MASS_UNIT_TROY_POUND",
+		15: "MASS_UNIT_PENNYWEIGHT",
+		16: "MASS_UNIT_CARAT",
+		17: "MASS_UNIT_ATOMIC_MASS_UNIT",
+		18: "MASS_UNIT_SLUG",
+	}
+	MassUnit_value = map[string]int32{
+		"MASS_UNIT_UNSPECIFIED":      0,
+		"MASS_UNIT_KILOGRAM":         1,
+		"MASS_UNIT_GRAM":             2,
+		"MASS_UNIT_POUND":            3,
+		"MASS_UNIT_OUNCE":            4,
+		"MASS_UNIT_TONNE":            5,
+		"MASS_UNIT_STONE":            6,
+		"MASS_UNIT_MILLIGRAM":        7,
+		"MASS_UNIT_MICROGRAM":        8,
+		"MASS_UNIT_SHORT_TON":        9,
+		"MASS_UNIT_LONG_TON":         10,
+		"MASS_UNIT_GRAIN":            11,
+		"MASS_UNIT_DRAM":             12,
+		"MASS_UNIT_TROY_OUNCE":       13,
+		"MASS_UNIT_TROY_POUND":       14,
+		"MASS_UNIT_PENNYWEIGHT":      15,
+		"MASS_UNIT_CARAT":            16,
+		"MASS_UNIT_ATOMIC_MASS_UNIT": 17,
+		"MASS_UNIT_SLUG":             18,
+	}
+)
+
+func (x MassUnit) Enum() *MassUnit {
+	p := new(MassUnit)
+	*p = x
+	return p
+}
+
+func (x MassUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MassUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_measurements_proto_enumTypes[0].Descriptor()
+}
+
+func (MassUnit) Type() protoreflect.EnumType {
+	return &file_measurements_proto_enumTypes[0]
+}
+
+func (x MassUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MassUnit.Descriptor instead.
+func (MassUnit) EnumDescriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{0}
+}
+
+// PressureUnit mirrors measurements.PressureUnit.
+type PressureUnit int32
+
+const (
+	PressureUnit_PRESSURE_UNIT_UNSPECIFIED                              PressureUnit = 0
+	PressureUnit_PRESSURE_UNIT_TORR                                     PressureUnit = 1
+	PressureUnit_PRESSURE_UNIT_BAR                                      PressureUnit = 2
+	PressureUnit_PRESSURE_UNIT_PASCAL                                   PressureUnit = 3
+	PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH              PressureUnit = 4
+	PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_GAUGE        PressureUnit = 5
+	PressureUnit_PRESSURE_UNIT_BAR_GAUGE                                PressureUnit = 6
+	PressureUnit_PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_DIFFERENTIAL PressureUnit = 7
+	PressureUnit_PRESSURE_UNIT_ATMOSPHERE                               PressureUnit = 8
+	PressureUnit_PRESSURE_UNIT_TECHNICAL_ATMOSPHERE                     PressureUnit = 9
+	PressureUnit_PRESSURE_UNIT_MILLIMETRE_OF_MERCURY                    PressureUnit = 10
+	PressureUnit_PRESSURE_UNIT_INCH_OF_MERCURY                          PressureUnit = 11
+	PressureUnit_PRESSURE_UNIT_CENTIMETRE_OF_WATER                      PressureUnit = 12
+	PressureUnit_PRESSURE_UNIT_INCH_OF_WATER                            PressureUnit = 13
+	PressureUnit_PRESSURE_UNIT_MILLIBAR                                 PressureUnit = 14
+	PressureUnit_PRESSURE_UNIT_HECTOPASCAL                              PressureUnit = 15
+	PressureUnit_PRESSURE_UNIT_KILOPASCAL                               PressureUnit = 16
+	PressureUnit_PRESSURE_UNIT_MEGAPASCAL                               PressureUnit = 17
+	PressureUnit_PRESSURE_UNIT_KILOPOUND_FORCE_PER_SQUARE_INCH          PressureUnit = 18
+)
+
+// Enum value maps for PressureUnit.
+var (
+	PressureUnit_name = map[int32]string{
+		0:  "PRESSURE_UNIT_UNSPECIFIED",
+		1:  "PRESSURE_UNIT_TORR",
+		2:  "PRESSURE_UNIT_BAR",
+		3:  "PRESSURE_UNIT_PASCAL",
+		4:  "PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH",
+		5:  "PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_GAUGE",
+		6:  "PRESSURE_UNIT_BAR_GAUGE",
+		7:  "PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_DIFFERENTIAL",
+		8:  "PRESSURE_UNIT_ATMOSPHERE",
+		9:  "PRESSURE_UNIT_TECHNICAL_ATMOSPHERE",
+		10: "PRESSURE_UNIT_MILLIMETRE_OF_MERCURY",
+		11: "PRESSURE_UNIT_INCH_OF_MERCURY",
+		12: "PRESSURE_UNIT_CENTIMETRE_OF_WATER",
+		13: "PRESSURE_UNIT_INCH_OF_WATER",
+		14: "PRESSURE_UNIT_MILLIBAR",
+		15: "PRESSURE_UNIT_HECTOPASCAL",
+		16: "PRESSURE_UNIT_KILOPASCAL",
+		17: "PRESSURE_UNIT_MEGAPASCAL",
+		18: "PRESSURE_UNIT_KILOPOUND_FORCE_PER_SQUARE_INCH",
+	}
+	PressureUnit_value = map[string]int32{
+		"PRESSURE_UNIT_UNSPECIFIED":                              0,
+		"PRESSURE_UNIT_TORR":                                     1,
+		"PRESSURE_UNIT_BAR":                                      2,
+		"PRESSURE_UNIT_PASCAL":                                   3,
+		"PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH":              4,
+		"PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_GAUGE":        5,
+		"PRESSURE_UNIT_BAR_GAUGE":                                6,
+		"PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_DIFFERENTIAL": 7,
+		"PRESSURE_UNIT_ATMOSPHERE":                               8,
+		"PRESSURE_UNIT_TECHNICAL_ATMOSPHERE":                     9,
+		"PRESSURE_UNIT_MILLIMETRE_OF_MERCURY":                    10,
+		"PRESSURE_UNIT_INCH_OF_MERCURY":                          11,
+		"PRESSURE_UNIT_CENTIMETRE_OF_WATER":                      12,
+		"PRESSURE_UNIT_INCH_OF_WATER":                            13,
+		"PRESSURE_UNIT_MILLIBAR":                                 14,
+		"PRESSURE_UNIT_HECTOPASCAL":                              15,
+		"PRESSURE_UNIT_KILOPASCAL":                               16,
+		"PRESSURE_UNIT_MEGAPASCAL":                               17,
+		"PRESSURE_UNIT_KILOPOUND_FORCE_PER_SQUARE_INCH":          18,
+	}
+)
+
+func (x PressureUnit) Enum() *PressureUnit {
+	p := new(PressureUnit)
+	*p = x
+	return p
+}
+
+func (x PressureUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PressureUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_measurements_proto_enumTypes[1].Descriptor()
+}
+
+func (PressureUnit) Type() protoreflect.EnumType {
+	return &file_measurements_proto_enumTypes[1]
+}
+
+func (x PressureUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PressureUnit.Descriptor instead.
+func (PressureUnit) EnumDescriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{1}
+}
+
+// VolumeUnit mirrors measurements.VolumeType.
+type VolumeUnit int32
+
+const (
+	VolumeUnit_VOLUME_UNIT_UNSPECIFIED          VolumeUnit = 0
+	VolumeUnit_VOLUME_UNIT_MILLILITER           VolumeUnit = 1
+	VolumeUnit_VOLUME_UNIT_LITRE                VolumeUnit = 2
+	VolumeUnit_VOLUME_UNIT_US_FLUID_OUNCE       VolumeUnit = 3
+	VolumeUnit_VOLUME_UNIT_US_LEGAL_CUP         VolumeUnit = 4
+	VolumeUnit_VOLUME_UNIT_US_LIQUID_PINT       VolumeUnit = 5
+	VolumeUnit_VOLUME_UNIT_US_LIQUID_QUART      VolumeUnit = 6
+	VolumeUnit_VOLUME_UNIT_US_LIQUID_GALLON     VolumeUnit = 7
+	VolumeUnit_VOLUME_UNIT_IMPERIAL_FLUID_OUNCE VolumeUnit = 8
+	VolumeUnit_VOLUME_UNIT_IMPERIAL_CUP         VolumeUnit = 9
+	VolumeUnit_VOLUME_UNIT_IMPERIAL_PINT        VolumeUnit = 10
+	VolumeUnit_VOLUME_UNIT_IMPERIAL_QUART       VolumeUnit = 11
+	VolumeUnit_VOLUME_UNIT_IMPERIAL_GALLON      VolumeUnit = 12
+)
+
+// Enum value maps for VolumeUnit.
+var (
+	VolumeUnit_name = map[int32]string{
+		0:  "VOLUME_UNIT_UNSPECIFIED",
+		1:  "VOLUME_UNIT_MILLILITER",
+		2:  "VOLUME_UNIT_LITRE",
+		3:  "VOLUME_UNIT_US_FLUID_OUNCE",
+		4:  "VOLUME_UNIT_US_LEGAL_CUP",
+		5:  "VOLUME_UNIT_US_LIQUID_PINT",
+		6:  "VOLUME_UNIT_US_LIQUID_QUART",
+		7:  "VOLUME_UNIT_US_LIQUID_GALLON",
+		8:  "VOLUME_UNIT_IMPERIAL_FLUID_OUNCE",
+		9:  "VOLUME_UNIT_IMPERIAL_CUP",
+		10: "VOLUME_UNIT_IMPERIAL_PINT",
+		11: "VOLUME_UNIT_IMPERIAL_QUART",
+		12: "VOLUME_UNIT_IMPERIAL_GALLON",
+	}
+	VolumeUnit_value = map[string]int32{
+		"VOLUME_UNIT_UNSPECIFIED":          0,
+		"VOLUME_UNIT_MILLILITER":           1,
+		"VOLUME_UNIT_LITRE":                2,
+		"VOLUME_UNIT_US_FLUID_OUNCE":       3,
+		"VOLUME_UNIT_US_LEGAL_CUP":         4,
+		"VOLUME_UNIT_US_LIQUID_PINT":       5,
+		"VOLUME_UNIT_US_LIQUID_QUART":      6,
+		"VOLUME_UNIT_US_LIQUID_GALLON":     7,
+		"VOLUME_UNIT_IMPERIAL_FLUID_OUNCE": 8,
+		"VOLUME_UNIT_IMPERIAL_CUP":         9,
+		"VOLUME_UNIT_IMPERIAL_PINT":        10,
+		"VOLUME_UNIT_IMPERIAL_QUART":       11,
+		"VOLUME_UNIT_IMPERIAL_GALLON":      12,
+	}
+)
+
+func (x VolumeUnit) Enum() *VolumeUnit {
+	p := new(VolumeUnit)
+	*p = x
+	return p
+}
+
+func (x VolumeUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_measurements_proto_enumTypes[2].Descriptor()
+}
+
+func (VolumeUnit) Type() protoreflect.EnumType {
+	return &file_measurements_proto_enumTypes[2]
+}
+
+func (x VolumeUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeUnit.Descriptor instead.
+func (VolumeUnit) EnumDescriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{2}
+}
+
+// TemperatureUnit mirrors measurements.TemperatureUnit.
+type TemperatureUnit int32
+
+const (
+	TemperatureUnit_TEMPERATURE_UNIT_UNSPECIFIED TemperatureUnit = 0
+	TemperatureUnit_TEMPERATURE_UNIT_CELSIUS     TemperatureUnit = 1
+	TemperatureUnit_TEMPERATURE_UNIT_FAHRENHEIT  TemperatureUnit = 2
+	TemperatureUnit_TEMPERATURE_UNIT_KELVIN      TemperatureUnit = 3
+	TemperatureUnit_TEMPERATURE_UNIT_RANKINE     TemperatureUnit = 4
+	TemperatureUnit_TEMPERATURE_UNIT_REAUMUR     TemperatureUnit = 5
+	TemperatureUnit_TEMPERATURE_UNIT_ROMER       TemperatureUnit = 6
+	TemperatureUnit_TEMPERATURE_UNIT_DELISLE     TemperatureUnit = 7
+	TemperatureUnit_TEMPERATURE_UNIT_NEWTON      TemperatureUnit = 8
+)
+
+// Enum value maps for TemperatureUnit.
+var (
+	TemperatureUnit_name = map[int32]string{
+		0: "TEMPERATURE_UNIT_UNSPECIFIED",
+		1: "TEMPERATURE_UNIT_CELSIUS",
+		2: "TEMPERATURE_UNIT_FAHRENHEIT",
+		3: "TEMPERATURE_UNIT_KELVIN",
+		4: "TEMPERATURE_UNIT_RANKINE",
+		5: "TEMPERATURE_UNIT_REAUMUR",
+		6: "TEMPERATURE_UNIT_ROMER",
+		7: "TEMPERATURE_UNIT_DELISLE",
+		8: "TEMPERATURE_UNIT_NEWTON",
+	}
+	TemperatureUnit_value = map[string]int32{
+		"TEMPERATURE_UNIT_UNSPECIFIED": 0,
+		"TEMPERATURE_UNIT_CELSIUS":     1,
+		"TEMPERATURE_UNIT_FAHRENHEIT":  2,
+		"TEMPERATURE_UNIT_KELVIN":      3,
+		"TEMPERATURE_UNIT_RANKINE":     4,
+		"TEMPERATURE_UNIT_REAUMUR":     5,
+		"TEMPERATURE_UNIT_ROMER":       6,
+		"TEMPERATURE_UNIT_DELISLE":     7,
+		"TEMPERATURE_UNIT_NEWTON":      8,
+	}
+)
+
+func (x TemperatureUnit) Enum() *TemperatureUnit {
+	p := new(TemperatureUnit)
+	*p = x
+	return p
+}
+
+func (x TemperatureUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemperatureUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_measurements_proto_enumTypes[3].Descriptor()
+}
+
+func (TemperatureUnit) Type() protoreflect.EnumType {
+	return &file_measurements_proto_enumTypes[3]
+}
+
+func (x TemperatureUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemperatureUnit.Descriptor instead.
+func (TemperatureUnit) EnumDescriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{3}
+}
+
+type Mass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          MassUnit               `protobuf:"varint,2,opt,name=unit,proto3,enum=measurements.v1.MassUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mass) Reset() {
+	*x = Mass{}
+	mi := &file_measurements_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mass) ProtoMessage() {}
+
+func (x *Mass) ProtoReflect() protoreflect.Message {
+	mi := &file_measurements_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mass.ProtoReflect.Descriptor instead.
+func (*Mass) Descriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Mass) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Mass) GetUnit() MassUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return MassUnit_MASS_UNIT_UNSPECIFIED
+}
+
+type Pressure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          PressureUnit           `protobuf:"varint,2,opt,name=unit,proto3,enum=measurements.v1.PressureUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pressure) Reset() {
+	*x = Pressure{}
+	mi := &file_measurements_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pressure) ProtoMessage() {}
+
+func (x *Pressure) ProtoReflect() protoreflect.Message {
+	mi := &file_measurements_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pressure.ProtoReflect.Descriptor instead.
+func (*Pressure) Descriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Pressure) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Pressure) GetUnit() PressureUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return PressureUnit_PRESSURE_UNIT_UNSPECIFIED
+}
+
+type Volume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          VolumeUnit             `protobuf:"varint,2,opt,name=unit,proto3,enum=measurements.v1.VolumeUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_measurements_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_measurements_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Volume) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Volume) GetUnit() VolumeUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return VolumeUnit_VOLUME_UNIT_UNSPECIFIED
+}
+
+type Temperature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          TemperatureUnit        `protobuf:"varint,2,opt,name=unit,proto3,enum=measurements.v1.TemperatureUnit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Temperature) Reset() {
+	*x = Temperature{}
+	mi := &file_measurements_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Temperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Temperature) ProtoMessage() {}
+
+func (x *Temperature) ProtoReflect() protoreflect.Message {
+	mi := &file_measurements_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Temperature.ProtoReflect.Descriptor instead.
+func (*Temperature) Descriptor() ([]byte, []int) {
+	return file_measurements_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Temperature) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Temperature) GetUnit() TemperatureUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return TemperatureUnit_TEMPERATURE_UNIT_UNSPECIFIED
+}
+
+var File_measurements_proto protoreflect.FileDescriptor
+
+const file_measurements_proto_rawDesc = "" +
+	"\n" +
+	"\x12measurements.proto\x12\x0fmeasurements.v1\"K\n" +
+	"\x04Mass\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12-\n" +
+	"\x04unit\x18\x02 \x01(\x0e2\x19.measurements.v1.MassUnitR\x04unit\"S\n" +
+	"\bPressure\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x121\n" +
+	"\x04unit\x18\x02 \x01(\x0e2\x1d.measurements.v1.PressureUnitR\x04unit\"O\n" +
+	"\x06Volume\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12/\n" +
+	"\x04unit\x18\x02 \x01(\x0e2\x1b.measurements.v1.VolumeUnitR\x04unit\"Y\n" +
+	"\vTemperature\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x124\n" +
+	"\x04unit\x18\x02 \x01(\x0e2 .measurements.v1.TemperatureUnitR\x04unit*\xc9\x03\n" +
+	"\bMassUnit\x12\x19\n" +
+	"\x15MASS_UNIT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12MASS_UNIT_KILOGRAM\x10\x01\x12\x12\n" +
+	"\x0eMASS_UNIT_GRAM\x10\x02\x12\x13\n" +
+	"\x0fMASS_UNIT_POUND\x10\x03\x12\x13\n" +
+	"\x0fMASS_UNIT_OUNCE\x10\x04\x12\x13\n" +
+	"\x0fMASS_UNIT_TONNE\x10\x05\x12\x13\n" +
+	"\x0fMASS_UNIT_STONE\x10\x06\x12\x17\n" +
+	"\x13MASS_UNIT_MILLIGRAM\x10\a\x12\x17\n" +
+	"\x13MASS_UNIT_MICROGRAM\x10\b\x12\x17\n" +
+	"\x13MASS_UNIT_SHORT_TON\x10\t\x12\x16\n" +
+	"\x12MASS_UNIT_LONG_TON\x10\n" +
+	"\x12\x13\n" +
+	"\x0fMASS_UNIT_GRAIN\x10\v\x12\x12\n" +
+	"\x0eMASS_UNIT_DRAM\x10\f\x12\x18\n" +
+	"\x14MASS_UNIT_TROY_OUNCE\x10\r\x12\x18\n" +
+	"\x14MASS_UNIT_TROY_POUND\x10\x0e\x12\x19\n" +
+	"\x15MASS_UNIT_PENNYWEIGHT\x10\x0f\x12\x13\n" +
+	"\x0fMASS_UNIT_CARAT\x10\x10\x12\x1e\n" +
+	"\x1aMASS_UNIT_ATOMIC_MASS_UNIT\x10\x11\x12\x12\n" +
+	"\x0eMASS_UNIT_SLUG\x10\x12*\xb7\x05\n" +
+	"\fPressureUnit\x12\x1d\n" +
+	"\x19PRESSURE_UNIT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PRESSURE_UNIT_TORR\x10\x01\x12\x15\n" +
+	"\x11PRESSURE_UNIT_BAR\x10\x02\x12\x18\n" +
+	"\x14PRESSURE_UNIT_PASCAL\x10\x03\x12-\n" +
+	")PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH\x10\x04\x123\n" +
+	"/PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_GAUGE\x10\x05\x12\x1b\n" +
+	"\x17PRESSURE_UNIT_BAR_GAUGE\x10\x06\x12:\n" +
+	"6PRESSURE_UNIT_POUND_FORCE_PER_SQUARE_INCH_DIFFERENTIAL\x10\a\x12\x1c\n" +
+	"\x18PRESSURE_UNIT_ATMOSPHERE\x10\b\x12&\n" +
+	"\"PRESSURE_UNIT_TECHNICAL_ATMOSPHERE\x10\t\x12'\n" +
+	"#PRESSURE_UNIT_MILLIMETRE_OF_MERCURY\x10\n" +
+	"\x12!\n" +
+	"\x1dPRESSURE_UNIT_INCH_OF_MERCURY\x10\v\x12%\n" +
+	"!PRESSURE_UNIT_CENTIMETRE_OF_WATER\x10\f\x12\x1f\n" +
+	"\x1bPRESSURE_UNIT_INCH_OF_WATER\x10\r\x12\x1a\n" +
+	"\x16PRESSURE_UNIT_MILLIBAR\x10\x0e\x12\x1d\n" +
+	"\x19PRESSURE_UNIT_HECTOPASCAL\x10\x0f\x12\x1c\n" +
+	"\x18PRESSURE_UNIT_KILOPASCAL\x10\x10\x12\x1c\n" +
+	"\x18PRESSURE_UNIT_MEGAPASCAL\x10\x11\x121\n" +
+	"-PRESSURE_UNIT_KILOPOUND_FORCE_PER_SQUARE_INCH\x10\x12*\xa1\x03\n" +
+	"\n" +
+	"VolumeUnit\x12\x1b\n" +
+	"\x17VOLUME_UNIT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16VOLUME_UNIT_MILLILITER\x10\x01\x12\x15\n" +
+	"\x11VOLUME_UNIT_LITRE\x10\x02\x12\x1e\n" +
+	"\x1aVOLUME_UNIT_US_FLUID_OUNCE\x10\x03\x12\x1c\n" +
+	"\x18VOLUME_UNIT_US_LEGAL_CUP\x10\x04\x12\x1e\n" +
+	"\x1aVOLUME_UNIT_US_LIQUID_PINT\x10\x05\x12\x1f\n" +
+	"\x1bVOLUME_UNIT_US_LIQUID_QUART\x10\x06\x12 \n" +
+	"\x1cVOLUME_UNIT_US_LIQUID_GALLON\x10\a\x12$\n" +
+	" VOLUME_UNIT_IMPERIAL_FLUID_OUNCE\x10\b\x12\x1c\n" +
+	"\x18VOLUME_UNIT_IMPERIAL_CUP\x10\t\x12\x1d\n" +
+	"\x19VOLUME_UNIT_IMPERIAL_PINT\x10\n" +
+	"\x12\x1e\n" +
+	"\x1aVOLUME_UNIT_IMPERIAL_QUART\x10\v\x12\x1f\n" +
+	"\x1bVOLUME_UNIT_IMPERIAL_GALLON\x10\f*\xa2\x02\n" +
+	"\x0fTemperatureUnit\x12 \n" +
+	"\x1cTEMPERATURE_UNIT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TEMPERATURE_UNIT_CELSIUS\x10\x01\x12\x1f\n" +
+	"\x1bTEMPERATURE_UNIT_FAHRENHEIT\x10\x02\x12\x1b\n" +
+	"\x17TEMPERATURE_UNIT_KELVIN\x10\x03\x12\x1c\n" +
+	"\x18TEMPERATURE_UNIT_RANKINE\x10\x04\x12\x1c\n" +
+	"\x18TEMPERATURE_UNIT_REAUMUR\x10\x05\x12\x1a\n" +
+	"\x16TEMPERATURE_UNIT_ROMER\x10\x06\x12\x1c\n" +
+	"\x18TEMPERATURE_UNIT_DELISLE\x10\a\x12\x1b\n" +
+	"\x17TEMPERATURE_UNIT_NEWTON\x10\bB4Z2github.com/RossMerr/go-measurements/measurementspbb\x06proto3"
+
+var (
+	file_measurements_proto_rawDescOnce sync.Once
+	file_measurements_proto_rawDescData []byte
+)
+
+func file_measurements_proto_rawDescGZIP() []byte {
+	file_measurements_proto_rawDescOnce.Do(func() {
+		file_measurements_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_measurements_proto_rawDesc), len(file_measurements_proto_rawDesc)))
+	})
+	return file_measurements_proto_rawDescData
+}
+
+var file_measurements_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_measurements_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_measurements_proto_goTypes = []any{
+	(MassUnit)(0),        // 0: measurements.v1.MassUnit
+	(PressureUnit)(0),    // 1: measurements.v1.PressureUnit
+	(VolumeUnit)(0),      // 2: measurements.v1.VolumeUnit
+	(TemperatureUnit)(0), // 3: measurements.v1.TemperatureUnit
+	(*Mass)(nil),         // 4: measurements.v1.Mass
+	(*Pressure)(nil),     // 5: measurements.v1.Pressure
+	(*Volume)(nil),       // 6: measurements.v1.Volume
+	(*Temperature)(nil),  // 7: measurements.v1.Temperature
+}
+var file_measurements_proto_depIdxs = []int32{
+	0, // 0: measurements.v1.Mass.unit:type_name -> measurements.v1.MassUnit
+	1, // 1: measurements.v1.Pressure.unit:type_name -> measurements.v1.PressureUnit
+	2, // 2: measurements.v1.Volume.unit:type_name -> measurements.v1.VolumeUnit
+	3, // 3: measurements.v1.Temperature.unit:type_name -> measurements.v1.TemperatureUnit
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_measurements_proto_init() }
+func file_measurements_proto_init() {
+	if File_measurements_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_measurements_proto_rawDesc), len(file_measurements_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_measurements_proto_goTypes,
+		DependencyIndexes: file_measurements_proto_depIdxs,
+		EnumInfos:         file_measurements_proto_enumTypes,
+		MessageInfos:      file_measurements_proto_msgTypes,
+	}.Build()
+	File_measurements_proto = out.File
+	file_measurements_proto_goTypes = nil
+	file_measurements_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrSyntax      = errors.New("measurements: invalid syntax")
+	ErrUnknownUnit = errors.New("measurements: unknown unit")
+)
+
+// parseMeasurement splits s into a number and a unit of kind, such as
+// "1.5 kg", "-40°F" or "2 imperial pints". A sum of terms in mixed units,
+// such as "11 lb 6 oz", is returned in its last unit; a leading sign
+// applies to the whole sum.
+func parseMeasurement(kind Kind, s string) (float64, UnitInfo, error) {
+	s = strings.TrimSpace(s)
+	value, info, rest, err := parseTerm(kind, s)
+	if err != nil || rest == "" {
+		return value, info, err
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	terms := []UnitInfo{info}
+	values := []float64{math.Abs(value)}
+	for rest != "" {
+		value, info, rest, err = parseTerm(kind, rest)
+		if err != nil {
+			return 0, UnitInfo{}, err
+		}
+		terms = append(terms, info)
+		values = append(values, value)
+	}
+
+	last := terms[len(terms)-1]
+	total := 0.0
+	for i, term := range terms {
+		if term.Offset != 0 {
+			return 0, UnitInfo{}, fmt.Errorf("%w: %q mixes %s units with an offset", ErrSyntax, s, kind)
+		}
+		total += values[i] * term.Factor / last.Factor
+	}
+	if negative {
+		total = -total
+	}
+	return total, last, nil
+}
+
+// parseTerm reads a number and its unit from the start of s. The unit runs
+// to the end of s or, when that is not a unit, to the start of the next
+// number, which begins the rest of s.
+func parseTerm(kind Kind, s string) (float64, UnitInfo, string, error) {
+	i := numberPrefix(s)
+	if i == 0 {
+		return 0, UnitInfo{}, "", fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	value, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, UnitInfo{}, "", fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	symbol := strings.TrimSpace(s[i:])
+	if info, ok := LookupUnit(kind, symbol); ok {
+		return value, info, "", nil
+	}
+	for j := i + 1; j < len(s); j++ {
+		if !isDigit(s[j]) {
+			continue
+		}
+		if info, ok := LookupUnit(kind, strings.TrimSpace(s[i:j])); ok {
+			return value, info, strings.TrimSpace(s[j:]), nil
+		}
+	}
+	return 0, UnitInfo{}, "", fmt.Errorf("%w: %s %q", ErrUnknownUnit, kind, symbol)
+}
+
+// numberPrefix returns the length of the decimal number at the start of s.
+func numberPrefix(s string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	start := i
+	for i < len(s) && (isDigit(s[i]) || s[i] == '.') {
+		i++
+	}
+	if i == start {
+		return 0
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
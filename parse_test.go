@@ -0,0 +1,78 @@
+package measurements_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_ParseMass(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    measurements.Mass
+		wantErr error
+	}{
+		{name: "Symbol", input: "1.5 kg", want: measurements.FromKilogram(1.5)},
+		{name: "No space", input: "12oz", want: measurements.FromOunce(12)},
+		{name: "Exponent", input: "2.5e3 g", want: measurements.FromGram(2500)},
+		{name: "Name", input: "-3 pounds", want: measurements.FromPound(-3)},
+		{name: "Unknown unit", input: "3 furlongs", wantErr: measurements.ErrUnknownUnit},
+		{name: "Missing number", input: "kg", wantErr: measurements.ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.ParseMass(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMass() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Unit() != tt.want.Unit() || got.Value() != tt.want.Value()) {
+				t.Errorf("ParseMass() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParsePressure(t *testing.T) {
+	got, err := measurements.ParsePressure("30 psi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := measurements.FromPoundForcePerSquareInch(30); got.String() != want.String() {
+		t.Errorf("ParsePressure() = %v, want %v", got, want)
+	}
+}
+
+func Test_ParseVolume(t *testing.T) {
+	got, err := measurements.ParseVolume("2 imp fl oz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := measurements.FromImperialFluidOunce(2); got.String() != want.String() {
+		t.Errorf("ParseVolume() = %v, want %v", got, want)
+	}
+}
+
+func Test_ParseTemperature(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  measurements.Temperature
+	}{
+		{name: "Degree sign", input: "-40°F", want: measurements.FromFahrenheit(-40)},
+		{name: "Bare symbol", input: "21.5 C", want: measurements.FromCelsius(21.5)},
+		{name: "Kelvin", input: "300 K", want: measurements.FromKelvin(300)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.ParseTemperature(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want.String() {
+				t.Errorf("ParseTemperature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Prefix is an SI prefix, stored as its power of ten.
+type Prefix int8
+
+const (
+	Yocto Prefix = -24
+	Zepto Prefix = -21
+	Atto  Prefix = -18
+	Femto Prefix = -15
+	Pico  Prefix = -12
+	Nano  Prefix = -9
+	Micro Prefix = -6
+	Milli Prefix = -3
+	Centi Prefix = -2
+	Deci  Prefix = -1
+	Deca  Prefix = 1
+	Hecto Prefix = 2
+	Kilo  Prefix = 3
+	Mega  Prefix = 6
+	Giga  Prefix = 9
+	Tera  Prefix = 12
+	Peta  Prefix = 15
+	Exa   Prefix = 18
+	Zetta Prefix = 21
+	Yotta Prefix = 24
+)
+
+// Prefixes lists every SI prefix from smallest to largest.
+var Prefixes = []Prefix{Yocto, Zepto, Atto, Femto, Pico, Nano, Micro, Milli, Centi, Deci, Deca, Hecto, Kilo, Mega, Giga, Tera, Peta, Exa, Zetta, Yotta}
+
+var PrefixName = map[Prefix]string{
+	Yocto: "yocto",
+	Zepto: "zepto",
+	Atto:  "atto",
+	Femto: "femto",
+	Pico:  "pico",
+	Nano:  "nano",
+	Micro: "micro",
+	Milli: "milli",
+	Centi: "centi",
+	Deci:  "deci",
+	Deca:  "deca",
+	Hecto: "hecto",
+	Kilo:  "kilo",
+	Mega:  "mega",
+	Giga:  "giga",
+	Tera:  "tera",
+	Peta:  "peta",
+	Exa:   "exa",
+	Zetta: "zetta",
+	Yotta: "yotta",
+}
+
+var PrefixSymbol = map[Prefix]string{
+	Yocto: "y",
+	Zepto: "z",
+	Atto:  "a",
+	Femto: "f",
+	Pico:  "p",
+	Nano:  "n",
+	Micro: "µ",
+	Milli: "m",
+	Centi: "c",
+	Deci:  "d",
+	Deca:  "da",
+	Hecto: "h",
+	Kilo:  "k",
+	Mega:  "M",
+	Giga:  "G",
+	Tera:  "T",
+	Peta:  "P",
+	Exa:   "E",
+	Zetta: "Z",
+	Yotta: "Y",
+}
+
+// prefixSymbolAliases and prefixNameAliases are further spellings accepted
+// when parsing: the Greek mu and ASCII u for micro, and the US deka.
+var prefixSymbolAliases = map[string]Prefix{
+	"μ": Micro,
+	"u": Micro,
+}
+
+var prefixNameAliases = map[string]Prefix{
+	"deka": Deca,
+}
+
+var ErrNotPrefixable = errors.New("measurements: unit does not take a prefix")
+
+func (s Prefix) String() string {
+	return PrefixName[s]
+}
+
+func (s Prefix) Symbol() string {
+	return PrefixSymbol[s]
+}
+
+// Factor returns the multiple of the unit the prefix stands for.
+func (s Prefix) Factor() float64 {
+	return math.Pow10(int(s))
+}
+
+// WithPrefix returns unit scaled by prefix, such as Pascal with Hecto for
+// hectopascals. Prefixed units are registered the first time they are used;
+// combinations that are built-in units, such as Gram with Kilo, return the
+// built-in unit.
+func WithPrefix[U Unit](unit U, prefix Prefix) (U, error) {
+	info, ok := lookupUnitInfo(unit.Kind(), unit)
+	if !ok {
+		return unit, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, unit.Kind(), unit)
+	}
+	prefixed, err := prefixedUnit(info, prefix)
+	if err != nil {
+		return unit, err
+	}
+	return prefixed.Unit.(U), nil
+}
+
+func prefixedUnit(info UnitInfo, prefix Prefix) (UnitInfo, error) {
+	if !info.Prefixable {
+		return UnitInfo{}, fmt.Errorf("%w: %s", ErrNotPrefixable, info.Plural)
+	}
+	if _, ok := PrefixName[prefix]; !ok {
+		return UnitInfo{}, fmt.Errorf("measurements: unknown prefix %d", prefix)
+	}
+
+	// Dividing by a power of ten rounds better than multiplying by its
+	// inexact reciprocal.
+	factor := info.Factor * prefix.Factor()
+	if prefix < 0 {
+		factor = info.Factor / math.Pow10(-int(prefix))
+	}
+	want := UnitInfo{
+		Kind:     info.Kind,
+		Symbol:   prefix.Symbol() + info.Symbol,
+		Singular: prefix.String() + info.Singular,
+		Plural:   prefix.String() + info.Plural,
+		System:   info.System,
+		Factor:   factor,
+		name:     prefix.Symbol() + info.Symbol,
+		root:     info.Unit,
+		prefix:   prefix,
+	}
+
+	existing := func() (UnitInfo, bool, error) {
+		found, ok := lookupSymbol(info.Kind, want.Symbol)
+		if !ok {
+			return UnitInfo{}, false, nil
+		}
+		if math.Abs(found.Factor-factor) > 1e-9*math.Abs(factor) || found.Offset != 0 {
+			return UnitInfo{}, true, fmt.Errorf("%w: %s symbol %q", ErrDuplicateUnit, info.Kind, want.Symbol)
+		}
+		return found, true, nil
+	}
+	if found, ok, err := existing(); ok {
+		return found, err
+	}
+	registered, err := addUnit(want)
+	if errors.Is(err, ErrDuplicateUnit) {
+		// Another goroutine registered the same unit first.
+		if found, ok, err := existing(); ok {
+			return found, err
+		}
+	}
+	return registered, err
+}
+
+// lookupPrefixed finds a prefixable unit of kind written with an SI prefix,
+// by symbol or by name, registering it if needed.
+func lookupPrefixed(kind Kind, s string) (UnitInfo, bool) {
+	for _, info := range registeredUnits(kind) {
+		if !info.Prefixable {
+			continue
+		}
+		for _, prefix := range Prefixes {
+			if !matchesPrefixed(s, prefix, info) {
+				continue
+			}
+			if prefixed, err := prefixedUnit(info, prefix); err == nil {
+				return prefixed, true
+			}
+		}
+	}
+	return UnitInfo{}, false
+}
+
+func matchesPrefixed(s string, prefix Prefix, info UnitInfo) bool {
+	symbols := []string{prefix.Symbol()}
+	for alias, p := range prefixSymbolAliases {
+		if p == prefix {
+			symbols = append(symbols, alias)
+		}
+	}
+	names := []string{prefix.String()}
+	for alias, p := range prefixNameAliases {
+		if p == prefix {
+			names = append(names, alias)
+		}
+	}
+
+	for _, symbol := range symbols {
+		if rest, ok := strings.CutPrefix(s, symbol); ok && rest != "" && info.hasSymbol(rest) {
+			return true
+		}
+	}
+	for _, name := range names {
+		if len(s) <= len(name) || !strings.EqualFold(s[:len(name)], name) {
+			continue
+		}
+		rest := s[len(name):]
+		if strings.EqualFold(rest, info.Singular) || strings.EqualFold(rest, info.Plural) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package measurements_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_WithPrefix(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	hectopascal, err := measurements.WithPrefix(measurements.Pascal, measurements.Hecto)
+	if err != nil {
+		t.Fatalf("WithPrefix() error = %v", err)
+	}
+	millibar, err := measurements.WithPrefix(measurements.Bar, measurements.Milli)
+	if err != nil {
+		t.Fatalf("WithPrefix() error = %v", err)
+	}
+	milligram, err := measurements.WithPrefix(measurements.Gram, measurements.Milli)
+	if err != nil {
+		t.Fatalf("WithPrefix() error = %v", err)
+	}
+	microlitre, err := measurements.WithPrefix(measurements.Litre, measurements.Micro)
+	if err != nil {
+		t.Fatalf("WithPrefix() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  measurements.Measurement
+		want string
+	}{
+		{
+			name: "Hectopascal to Millibar",
+			got:  measurements.NewPressure(hectopascal, 1013.25).To(millibar),
+			want: "1013.25 mbar",
+		},
+		{
+			name: "Pascal to Hectopascal",
+			got:  measurements.FromPascal(101325).To(hectopascal),
+			want: "1013.25 hPa",
+		},
+		{
+			name: "Milligram to Gram",
+			got:  measurements.NewMass(milligram, 2500).ToGram(),
+			want: "2.50 g",
+		},
+		{
+			name: "Millilitre to Microlitre",
+			got:  measurements.FromMilliliter(0.25).To(microlitre),
+			want: "250.00 µl",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if again, _ := measurements.WithPrefix(measurements.Pascal, measurements.Hecto); again != hectopascal {
+		t.Errorf("WithPrefix() = %#v, want %#v", again, hectopascal)
+	}
+	if got, want := hectopascal.Singular(), "hectopascal"; got != want {
+		t.Errorf("Singular() = %v, want %v", got, want)
+	}
+}
+
+func Test_WithPrefix_builtIn(t *testing.T) {
+	tests := []struct {
+		name   string
+		unit   measurements.Unit
+		prefix measurements.Prefix
+		want   measurements.Unit
+	}{
+		{
+			name:   "Kilogram",
+			unit:   measurements.Gram,
+			prefix: measurements.Kilo,
+			want:   measurements.Kilogram,
+		},
+		{
+			name:   "Millilitre",
+			unit:   measurements.Litre,
+			prefix: measurements.Milli,
+			want:   measurements.Milliliter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.WithPrefix(tt.unit, tt.prefix)
+			if err != nil {
+				t.Fatalf("WithPrefix() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("WithPrefix() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_WithPrefix_notPrefixable(t *testing.T) {
+	if _, err := measurements.WithPrefix(measurements.Pound, measurements.Kilo); !errors.Is(err, measurements.ErrNotPrefixable) {
+		t.Errorf("WithPrefix() error = %v, want %v", err, measurements.ErrNotPrefixable)
+	}
+	if _, err := measurements.WithPrefix(measurements.Celsius, measurements.Milli); !errors.Is(err, measurements.ErrNotPrefixable) {
+		t.Errorf("WithPrefix() error = %v, want %v", err, measurements.ErrNotPrefixable)
+	}
+}
+
+func Test_ParsePrefixed(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	tests := []struct {
+		name string
+		kind measurements.Kind
+		s    string
+		base string
+	}{
+		{
+			name: "hPa",
+			kind: measurements.PressureKind,
+			s:    "1013.25 hPa",
+			base: "101325.00 Pa",
+		},
+		{
+			name: "kilopascals",
+			kind: measurements.PressureKind,
+			s:    "2 kilopascals",
+			base: "2000.00 Pa",
+		},
+		{
+			name: "MPa",
+			kind: measurements.PressureKind,
+			s:    "0.5 MPa",
+			base: "500000.00 Pa",
+		},
+		{
+			name: "mg",
+			kind: measurements.MassKind,
+			s:    "250000 mg",
+			base: "0.25 kg",
+		},
+		{
+			name: "Mg",
+			kind: measurements.MassKind,
+			s:    "1.5 Mg",
+			base: "1500.00 kg",
+		},
+		{
+			name: "µL",
+			kind: measurements.VolumeKind,
+			s:    "500000 µL",
+			base: "0.50 l",
+		},
+		{
+			name: "Greek mu",
+			kind: measurements.VolumeKind,
+			s:    "500000 μl",
+			base: "0.50 l",
+		},
+		{
+			name: "mK",
+			kind: measurements.TemperatureKind,
+			s:    "300000 mK",
+			base: "300.00 K",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got string
+				err error
+			)
+			switch tt.kind {
+			case measurements.MassKind:
+				var m measurements.Mass
+				if m, err = measurements.ParseMass(tt.s); err == nil {
+					got = m.ToKilogram().String()
+				}
+			case measurements.PressureKind:
+				var p measurements.Pressure
+				if p, err = measurements.ParsePressure(tt.s); err == nil {
+					got = p.ToPascal().String()
+				}
+			case measurements.VolumeKind:
+				var v measurements.Volume
+				if v, err = measurements.ParseVolume(tt.s); err == nil {
+					got = v.ToLiter().String()
+				}
+			case measurements.TemperatureKind:
+				var k measurements.Temperature
+				if k, err = measurements.ParseTemperature(tt.s); err == nil {
+					got = k.ToKelvin().String()
+				}
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got != tt.base {
+				t.Errorf("Parse() = %v, want %v", got, tt.base)
+			}
+		})
+	}
+
+	if _, err := measurements.ParseMass("3 klb"); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("ParseMass() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
@@ -1,147 +1,121 @@
 package measurements
 
-import "fmt"
-
-type PressureUnit int32
-
-const (
-	Torr PressureUnit = iota
-	Bar
-	Pascal
-	PoundForcePerSquareInch
+import (
+	"errors"
+	"fmt"
 )
 
-var PressureUnitName = map[PressureUnit]string{
-	Torr:                    "Torr",
-	Bar:                     "bar",
-	Pascal:                  "Pa",
-	PoundForcePerSquareInch: "psi",
-}
+// ErrDifferential is returned when a differential pressure is converted to
+// or from an absolute or gauge pressure, which needs a reference it lacks.
+var ErrDifferential = errors.New("measurements: differential pressure has no reference")
 
-var PressureUnitValue = map[string]PressureUnit{
-	"Torr": Torr,
-	"bar":  Bar,
-	"Pa":   Pascal,
-	"psi":  PoundForcePerSquareInch,
-}
-
-func (s PressureUnit) String() string {
-	return PressureUnitName[s]
-}
+// PressureReference is the zero point a pressure is measured from.
+type PressureReference int
 
-type Pressure interface {
-	Unit() PressureUnit
-	Value() float64
-	String() string
-
-	To(PressureUnit) Pressure
-	ToTorr() Pressure
-	ToBar() Pressure
-	ToPascal() Pressure
-	ToPoundForcePerSquareInch() Pressure
-}
+const (
+	// Absolute pressures are measured from a vacuum, as psia and bara.
+	Absolute PressureReference = iota
+	// Gauge pressures are measured from the surrounding atmosphere, as psig
+	// and barg, and are negative below it.
+	Gauge
+	// Differential pressures are the difference between two points, as psid.
+	Differential
+)
 
-type pressure struct {
-	unit  PressureUnit
-	value float64
+var pressureReferenceName = map[PressureReference]string{
+	Absolute:     "absolute",
+	Gauge:        "gauge",
+	Differential: "differential",
 }
 
-func NewPressure(unit PressureUnit, value float64) Pressure {
-	return &pressure{
-		unit:  unit,
-		value: value,
+func (s PressureReference) String() string {
+	if name, ok := pressureReferenceName[s]; ok {
+		return name
 	}
+	return fmt.Sprintf("PressureReference(%d)", int(s))
 }
 
-func (s *pressure) Unit() PressureUnit {
-	return s.unit
-}
-
-func (s *pressure) Value() float64 {
-	return s.value
-}
-
-func (s pressure) String() string {
-	return fmt.Sprintf("%.2f %s", s.value, s.unit)
-}
-
-func FromTorr(value float64) Pressure {
-	return &pressure{unit: Torr, value: value}
-}
-
-func FromBar(value float64) Pressure {
-	return &pressure{unit: Bar, value: value}
-}
+// StandardAtmosphere is 101325 Pa, the atmosphere the gauge units are read
+// against by To and the other conversions.
+var StandardAtmosphere = FromPascal(101325)
 
-func FromPascal(value float64) Pressure {
-	return &pressure{unit: Pascal, value: value}
+// Reference returns the zero point of the unit. Units registered at run
+// time are absolute.
+func (s PressureUnit) Reference() PressureReference {
+	return pressureReferences[s]
 }
 
-func FromPoundForcePerSquareInch(value float64) Pressure {
-	return &pressure{unit: PoundForcePerSquareInch, value: value}
+// sameReference reports whether a and b measure from the same zero point.
+// Only pressures have references, so units of other kinds always do.
+func sameReference(a, b Unit) bool {
+	pa, ok := a.(PressureUnit)
+	if !ok {
+		return true
+	}
+	pb, ok := b.(PressureUnit)
+	return !ok || pa.Reference() == pb.Reference()
 }
 
-func (s *pressure) To(unit PressureUnit) Pressure {
-	switch unit {
-	default: // Torr
-		return s.ToTorr()
-	case Bar:
-		return s.ToBar()
-	case Pascal:
-		return s.ToPascal()
-	case PoundForcePerSquareInch:
-		return s.ToPoundForcePerSquareInch()
+// crossesDifferential reports whether converting from one unit to the other
+// would read a differential pressure as an absolute or gauge one, or the
+// other way round.
+func crossesDifferential(from, to Unit) bool {
+	pf, ok := from.(PressureUnit)
+	if !ok {
+		return false
 	}
+	pt, ok := to.(PressureUnit)
+	return ok && (pf.Reference() == Differential) != (pt.Reference() == Differential)
 }
 
-func (s *pressure) ToTorr() Pressure {
-	switch s.Unit() {
-	default: // Torr
-		return FromTorr(s.Value())
-	case Bar:
-		return FromTorr(s.Value() * 750)
-	case Pascal:
-		return FromTorr(s.Value() / 133)
-	case PoundForcePerSquareInch:
-		return FromTorr(s.Value() * 51.715)
+// ToAbsolute converts p to unit, an absolute unit, reading a gauge p
+// against the local barometric pressure atmosphere.
+func ToAbsolute(p Pressure, unit PressureUnit, atmosphere Pressure) (Pressure, error) {
+	if unit.Reference() != Absolute {
+		return nil, fmt.Errorf("measurements: %s is not an absolute unit", unit.Symbol())
 	}
+	return convertPressure(p, unit, atmosphere)
 }
 
-func (s *pressure) ToBar() Pressure {
-	switch s.Unit() {
-	default: // Torr
-		return FromBar(s.Value() / 750)
-	case Bar:
-		return FromBar(s.Value())
-	case Pascal:
-		return FromBar(s.Value() / 100000)
-	case PoundForcePerSquareInch:
-		return FromBar(s.Value() / 14.504)
+// ToGauge converts p to unit, a gauge unit, measuring it from the local
+// barometric pressure atmosphere.
+func ToGauge(p Pressure, unit PressureUnit, atmosphere Pressure) (Pressure, error) {
+	if unit.Reference() != Gauge {
+		return nil, fmt.Errorf("measurements: %s is not a gauge unit", unit.Symbol())
 	}
+	return convertPressure(p, unit, atmosphere)
 }
 
-func (s *pressure) ToPascal() Pressure {
-	switch s.Unit() {
-	default: // Torr
-		return FromPascal(s.Value() * 133)
-	case Bar:
-		return FromPascal(s.Value() * 100000)
-	case Pascal:
-		return FromPascal(s.Value())
-	case PoundForcePerSquareInch:
-		return FromPascal(s.Value() * 6895)
+// convertPressure converts between absolute and gauge units through the
+// absolute pressure in pascals.
+func convertPressure(p Pressure, unit PressureUnit, atmosphere Pressure) (Pressure, error) {
+	from, ok := lookupUnitInfo(PressureKind, p.Unit())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, PressureKind, p.Unit())
+	}
+	to, ok := lookupUnitInfo(PressureKind, unit)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, PressureKind, unit)
+	}
+	if atmosphere.Unit().Reference() != Absolute {
+		return nil, fmt.Errorf("measurements: atmosphere %v is not an absolute pressure", atmosphere)
+	}
+	air, err := atmosphere.ConvertTo(Pascal)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (s *pressure) ToPoundForcePerSquareInch() Pressure {
-	switch s.Unit() {
-	default: // Torr
-		return FromPoundForcePerSquareInch(s.Value() / 51.715)
-	case Bar:
-		return FromPoundForcePerSquareInch(s.Value() * 14.504)
-	case Pascal:
-		return FromPoundForcePerSquareInch(s.Value() / 6895)
-	case PoundForcePerSquareInch:
-		return FromPoundForcePerSquareInch(s.Value())
+	var pascals float64
+	switch p.Unit().Reference() {
+	case Absolute:
+		pascals = from.ToBase(p.Value())
+	case Gauge:
+		pascals = p.Value()*from.Factor + air.Value()
+	default:
+		return nil, ErrDifferential
+	}
+	if unit.Reference() == Gauge {
+		return NewPressure(unit, (pascals-air.Value())/to.Factor), nil
 	}
+	return NewPressure(unit, to.FromBase(pascals)), nil
 }
@@ -0,0 +1,373 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements
+
+type PressureUnit int32
+
+const (
+	Torr PressureUnit = iota
+	Bar
+	Pascal
+	PoundForcePerSquareInch
+	PoundForcePerSquareInchGauge
+	BarGauge
+	PoundForcePerSquareInchDifferential
+	Atmosphere
+	TechnicalAtmosphere
+	MillimetreOfMercury
+	InchOfMercury
+	CentimetreOfWater
+	InchOfWater
+	Millibar
+	Hectopascal
+	Kilopascal
+	Megapascal
+	KilopoundForcePerSquareInch
+)
+
+// InvalidPressureUnit is returned by RegisterPressureUnit on error. It is
+// never a pressure unit, so IsValid reports false and ConvertTo and the
+// encodings reject it.
+const InvalidPressureUnit PressureUnit = -1
+
+// PressureUnitName and PressureUnitValue hold the built-in pressure units only; units
+// added by RegisterPressureUnit are found through LookupUnit.
+var PressureUnitName = map[PressureUnit]string{
+	Torr:                                "Torr",
+	Bar:                                 "bar",
+	Pascal:                              "Pa",
+	PoundForcePerSquareInch:             "psi",
+	PoundForcePerSquareInchGauge:        "psig",
+	BarGauge:                            "barg",
+	PoundForcePerSquareInchDifferential: "psid",
+	Atmosphere:                          "atm",
+	TechnicalAtmosphere:                 "at",
+	MillimetreOfMercury:                 "mmHg",
+	InchOfMercury:                       "inHg",
+	CentimetreOfWater:                   "cmH2O",
+	InchOfWater:                         "inH2O",
+	Millibar:                            "mbar",
+	Hectopascal:                         "hPa",
+	Kilopascal:                          "kPa",
+	Megapascal:                          "MPa",
+	KilopoundForcePerSquareInch:         "ksi",
+}
+
+var PressureUnitValue = map[string]PressureUnit{
+	"Torr":  Torr,
+	"bar":   Bar,
+	"Pa":    Pascal,
+	"psi":   PoundForcePerSquareInch,
+	"psig":  PoundForcePerSquareInchGauge,
+	"barg":  BarGauge,
+	"psid":  PoundForcePerSquareInchDifferential,
+	"atm":   Atmosphere,
+	"at":    TechnicalAtmosphere,
+	"mmHg":  MillimetreOfMercury,
+	"inHg":  InchOfMercury,
+	"cmH2O": CentimetreOfWater,
+	"inH2O": InchOfWater,
+	"mbar":  Millibar,
+	"hPa":   Hectopascal,
+	"kPa":   Kilopascal,
+	"MPa":   Megapascal,
+	"ksi":   KilopoundForcePerSquareInch,
+}
+
+// pressureReferences holds the built-in pressure units that are not
+// measured from a vacuum.
+var pressureReferences = map[PressureUnit]PressureReference{
+	PoundForcePerSquareInchGauge:        Gauge,
+	BarGauge:                            Gauge,
+	PoundForcePerSquareInchDifferential: Differential,
+}
+
+var pressureUnits = []UnitInfo{
+	{Unit: Torr, Kind: PressureKind, Symbol: "Torr", Singular: "torr", Plural: "torr", Factor: 101325.0 / 760, name: "Torr", goName: "Torr"},
+	{Unit: Bar, Kind: PressureKind, Symbol: "bar", Singular: "bar", Plural: "bars", System: SI, Prefixable: true, Factor: 100000, name: "bar", aliases: []string{"bara"}, goName: "Bar"},
+	{Unit: Pascal, Kind: PressureKind, Symbol: "Pa", Singular: "pascal", Plural: "pascals", System: SI, Base: true, Prefixable: true, Factor: 1, name: "Pa", goName: "Pascal"},
+	{Unit: PoundForcePerSquareInch, Kind: PressureKind, Symbol: "psi", Singular: "pound-force per square inch", Plural: "pounds-force per square inch", System: USCustomary | Imperial, Factor: 6894.757293168361, name: "psi", aliases: []string{"psia"}, goName: "PoundForcePerSquareInch"},
+	{Unit: PoundForcePerSquareInchGauge, Kind: PressureKind, Symbol: "psig", Singular: "pound-force per square inch gauge", Plural: "pounds-force per square inch gauge", System: USCustomary | Imperial, Factor: 6894.757293168361, Offset: 101325, name: "psig", goName: "PoundForcePerSquareInchGauge"},
+	{Unit: BarGauge, Kind: PressureKind, Symbol: "barg", Singular: "bar gauge", Plural: "bars gauge", System: SI, Factor: 100000, Offset: 101325, name: "barg", goName: "BarGauge"},
+	{Unit: PoundForcePerSquareInchDifferential, Kind: PressureKind, Symbol: "psid", Singular: "pound-force per square inch differential", Plural: "pounds-force per square inch differential", System: USCustomary | Imperial, Factor: 6894.757293168361, name: "psid", goName: "PoundForcePerSquareInchDifferential"},
+	{Unit: Atmosphere, Kind: PressureKind, Symbol: "atm", Singular: "standard atmosphere", Plural: "standard atmospheres", Factor: 101325, name: "atm", goName: "Atmosphere"},
+	{Unit: TechnicalAtmosphere, Kind: PressureKind, Symbol: "at", Singular: "technical atmosphere", Plural: "technical atmospheres", Factor: 98066.5, name: "at", goName: "TechnicalAtmosphere"},
+	{Unit: MillimetreOfMercury, Kind: PressureKind, Symbol: "mmHg", Singular: "millimetre of mercury", Plural: "millimetres of mercury", Factor: 133.322387415, name: "mmHg", goName: "MillimetreOfMercury"},
+	{Unit: InchOfMercury, Kind: PressureKind, Symbol: "inHg", Singular: "inch of mercury", Plural: "inches of mercury", System: USCustomary | Imperial, Factor: 3386.389, name: "inHg", goName: "InchOfMercury"},
+	{Unit: CentimetreOfWater, Kind: PressureKind, Symbol: "cmH2O", Singular: "centimetre of water", Plural: "centimetres of water", Factor: 98.0665, name: "cmH2O", aliases: []string{"cmH₂O"}, goName: "CentimetreOfWater"},
+	{Unit: InchOfWater, Kind: PressureKind, Symbol: "inH2O", Singular: "inch of water", Plural: "inches of water", System: USCustomary | Imperial, Factor: 249.08891, name: "inH2O", aliases: []string{"inH₂O"}, goName: "InchOfWater"},
+	{Unit: Millibar, Kind: PressureKind, Symbol: "mbar", Singular: "millibar", Plural: "millibars", System: SI, Factor: 100, name: "mbar", goName: "Millibar"},
+	{Unit: Hectopascal, Kind: PressureKind, Symbol: "hPa", Singular: "hectopascal", Plural: "hectopascals", System: SI, Factor: 100, name: "hPa", goName: "Hectopascal"},
+	{Unit: Kilopascal, Kind: PressureKind, Symbol: "kPa", Singular: "kilopascal", Plural: "kilopascals", System: SI, Factor: 1000, name: "kPa", goName: "Kilopascal"},
+	{Unit: Megapascal, Kind: PressureKind, Symbol: "MPa", Singular: "megapascal", Plural: "megapascals", System: SI, Factor: 1000000, name: "MPa", goName: "Megapascal"},
+	{Unit: KilopoundForcePerSquareInch, Kind: PressureKind, Symbol: "ksi", Singular: "kip per square inch", Plural: "kips per square inch", System: USCustomary | Imperial, Factor: 6894757.293168361, name: "ksi", goName: "KilopoundForcePerSquareInch"},
+}
+
+func (s PressureUnit) Kind() Kind {
+	return PressureKind
+}
+
+func (s PressureUnit) String() string {
+	return unitInfo(s).name
+}
+
+func (s PressureUnit) Symbol() string {
+	return s.Info().Symbol
+}
+
+func (s PressureUnit) Singular() string {
+	return s.Info().Singular
+}
+
+func (s PressureUnit) Plural() string {
+	return s.Info().Plural
+}
+
+func (s PressureUnit) Info() UnitInfo {
+	return unitInfo(s)
+}
+
+func (s PressureUnit) GoString() string {
+	return unitGoString(s, "PressureUnit", int32(s))
+}
+
+// IsValid reports whether s is a built-in or registered pressure unit.
+func (s PressureUnit) IsValid() bool {
+	_, ok := lookupUnitInfo(PressureKind, s)
+	return ok
+}
+
+type Pressure interface {
+	Measurement
+
+	Unit() PressureUnit
+	Value() float64
+	String() string
+
+	Validate() error
+
+	To(unit PressureUnit) Pressure
+	ConvertTo(unit PressureUnit) (Pressure, error)
+	ToTorr() Pressure
+	ToBar() Pressure
+	ToPascal() Pressure
+	ToPoundForcePerSquareInch() Pressure
+	ToPoundForcePerSquareInchGauge() Pressure
+	ToBarGauge() Pressure
+	ToPoundForcePerSquareInchDifferential() Pressure
+	ToAtmosphere() Pressure
+	ToTechnicalAtmosphere() Pressure
+	ToMillimetreOfMercury() Pressure
+	ToInchOfMercury() Pressure
+	ToCentimetreOfWater() Pressure
+	ToInchOfWater() Pressure
+	ToMillibar() Pressure
+	ToHectopascal() Pressure
+	ToKilopascal() Pressure
+	ToMegapascal() Pressure
+	ToKilopoundForcePerSquareInch() Pressure
+}
+
+type pressure struct {
+	Quantity[PressureUnit]
+}
+
+// RegisterPressureUnit adds a pressure unit at run time. The unit works with
+// To, parsing and formatting like the built-in units, but its enum value
+// depends on the order of registration, so it cannot be written by
+// MarshalBinary or an Encoder. On error it returns InvalidPressureUnit.
+func RegisterPressureUnit(def UnitDefinition) (PressureUnit, error) {
+	info, err := registerUnit(PressureKind, def)
+	if err != nil {
+		return InvalidPressureUnit, err
+	}
+	return info.Unit.(PressureUnit), nil
+}
+
+func NewPressure(unit PressureUnit, value float64) Pressure {
+	return &pressure{NewQuantity(unit, value)}
+}
+
+// NewPressureChecked is NewPressure for untrusted values: it returns a
+// *ValueError when value is NaN, infinite or physically impossible.
+func NewPressureChecked(unit PressureUnit, value float64) (Pressure, error) {
+	m := NewPressure(unit, value)
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func FromTorr(value float64) Pressure {
+	return NewPressure(Torr, value)
+}
+
+func FromBar(value float64) Pressure {
+	return NewPressure(Bar, value)
+}
+
+func FromPascal(value float64) Pressure {
+	return NewPressure(Pascal, value)
+}
+
+func FromPoundForcePerSquareInch(value float64) Pressure {
+	return NewPressure(PoundForcePerSquareInch, value)
+}
+
+func FromPoundForcePerSquareInchGauge(value float64) Pressure {
+	return NewPressure(PoundForcePerSquareInchGauge, value)
+}
+
+func FromBarGauge(value float64) Pressure {
+	return NewPressure(BarGauge, value)
+}
+
+func FromPoundForcePerSquareInchDifferential(value float64) Pressure {
+	return NewPressure(PoundForcePerSquareInchDifferential, value)
+}
+
+func FromAtmosphere(value float64) Pressure {
+	return NewPressure(Atmosphere, value)
+}
+
+func FromTechnicalAtmosphere(value float64) Pressure {
+	return NewPressure(TechnicalAtmosphere, value)
+}
+
+func FromMillimetreOfMercury(value float64) Pressure {
+	return NewPressure(MillimetreOfMercury, value)
+}
+
+func FromInchOfMercury(value float64) Pressure {
+	return NewPressure(InchOfMercury, value)
+}
+
+func FromCentimetreOfWater(value float64) Pressure {
+	return NewPressure(CentimetreOfWater, value)
+}
+
+func FromInchOfWater(value float64) Pressure {
+	return NewPressure(InchOfWater, value)
+}
+
+func FromMillibar(value float64) Pressure {
+	return NewPressure(Millibar, value)
+}
+
+func FromHectopascal(value float64) Pressure {
+	return NewPressure(Hectopascal, value)
+}
+
+func FromKilopascal(value float64) Pressure {
+	return NewPressure(Kilopascal, value)
+}
+
+func FromMegapascal(value float64) Pressure {
+	return NewPressure(Megapascal, value)
+}
+
+func FromKilopoundForcePerSquareInch(value float64) Pressure {
+	return NewPressure(KilopoundForcePerSquareInch, value)
+}
+
+func ParsePressure(s string) (Pressure, error) {
+	value, info, err := parseMeasurement(PressureKind, s)
+	if err != nil {
+		return nil, err
+	}
+	return NewPressure(info.Unit.(PressureUnit), value), nil
+}
+
+// BestPressureUnit returns the unit that shows m most readably, as chosen
+// by BestUnit.
+func BestPressureUnit(m Pressure, opts HumanizeOptions) PressureUnit {
+	return BestUnit(m, opts).(PressureUnit)
+}
+
+func (s *pressure) To(unit PressureUnit) Pressure {
+	return &pressure{s.Quantity.To(unit)}
+}
+
+func (s *pressure) convertedTo(unit Unit, value float64) Measurement {
+	return NewPressure(unit.(PressureUnit), value)
+}
+
+func (s *pressure) ConvertTo(unit PressureUnit) (Pressure, error) {
+	q, err := s.Quantity.ConvertTo(unit)
+	if err != nil {
+		return nil, err
+	}
+	return &pressure{q}, nil
+}
+
+func (s *pressure) ToTorr() Pressure {
+	return s.To(Torr)
+}
+
+func (s *pressure) ToBar() Pressure {
+	return s.To(Bar)
+}
+
+func (s *pressure) ToPascal() Pressure {
+	return s.To(Pascal)
+}
+
+func (s *pressure) ToPoundForcePerSquareInch() Pressure {
+	return s.To(PoundForcePerSquareInch)
+}
+
+func (s *pressure) ToPoundForcePerSquareInchGauge() Pressure {
+	return s.To(PoundForcePerSquareInchGauge)
+}
+
+func (s *pressure) ToBarGauge() Pressure {
+	return s.To(BarGauge)
+}
+
+func (s *pressure) ToPoundForcePerSquareInchDifferential() Pressure {
+	return s.To(PoundForcePerSquareInchDifferential)
+}
+
+func (s *pressure) ToAtmosphere() Pressure {
+	return s.To(Atmosphere)
+}
+
+func (s *pressure) ToTechnicalAtmosphere() Pressure {
+	return s.To(TechnicalAtmosphere)
+}
+
+func (s *pressure) ToMillimetreOfMercury() Pressure {
+	return s.To(MillimetreOfMercury)
+}
+
+func (s *pressure) ToInchOfMercury() Pressure {
+	return s.To(InchOfMercury)
+}
+
+func (s *pressure) ToCentimetreOfWater() Pressure {
+	return s.To(CentimetreOfWater)
+}
+
+func (s *pressure) ToInchOfWater() Pressure {
+	return s.To(InchOfWater)
+}
+
+func (s *pressure) ToMillibar() Pressure {
+	return s.To(Millibar)
+}
+
+func (s *pressure) ToHectopascal() Pressure {
+	return s.To(Hectopascal)
+}
+
+func (s *pressure) ToKilopascal() Pressure {
+	return s.To(Kilopascal)
+}
+
+func (s *pressure) ToMegapascal() Pressure {
+	return s.To(Megapascal)
+}
+
+func (s *pressure) ToKilopoundForcePerSquareInch() Pressure {
+	return s.To(KilopoundForcePerSquareInch)
+}
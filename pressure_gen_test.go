@@ -0,0 +1,123 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_pressure_generated(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.PressureUnit
+		// base is one of unit in pascals.
+		base float64
+	}{
+		{
+			name: "Torr",
+			unit: measurements.Torr,
+			base: 101325.0 / 760,
+		},
+		{
+			name: "Bar",
+			unit: measurements.Bar,
+			base: 100000,
+		},
+		{
+			name: "Pascal",
+			unit: measurements.Pascal,
+			base: 1,
+		},
+		{
+			name: "PoundForcePerSquareInch",
+			unit: measurements.PoundForcePerSquareInch,
+			base: 6894.757293168361,
+		},
+		{
+			name: "PoundForcePerSquareInchGauge",
+			unit: measurements.PoundForcePerSquareInchGauge,
+			base: 6894.757293168361 + 101325,
+		},
+		{
+			name: "BarGauge",
+			unit: measurements.BarGauge,
+			base: 100000 + 101325,
+		},
+		{
+			name: "Atmosphere",
+			unit: measurements.Atmosphere,
+			base: 101325,
+		},
+		{
+			name: "TechnicalAtmosphere",
+			unit: measurements.TechnicalAtmosphere,
+			base: 98066.5,
+		},
+		{
+			name: "MillimetreOfMercury",
+			unit: measurements.MillimetreOfMercury,
+			base: 133.322387415,
+		},
+		{
+			name: "InchOfMercury",
+			unit: measurements.InchOfMercury,
+			base: 3386.389,
+		},
+		{
+			name: "CentimetreOfWater",
+			unit: measurements.CentimetreOfWater,
+			base: 98.0665,
+		},
+		{
+			name: "InchOfWater",
+			unit: measurements.InchOfWater,
+			base: 249.08891,
+		},
+		{
+			name: "Millibar",
+			unit: measurements.Millibar,
+			base: 100,
+		},
+		{
+			name: "Hectopascal",
+			unit: measurements.Hectopascal,
+			base: 100,
+		},
+		{
+			name: "Kilopascal",
+			unit: measurements.Kilopascal,
+			base: 1000,
+		},
+		{
+			name: "Megapascal",
+			unit: measurements.Megapascal,
+			base: 1000000,
+		},
+		{
+			name: "KilopoundForcePerSquareInch",
+			unit: measurements.KilopoundForcePerSquareInch,
+			base: 6894757.293168361,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			one := measurements.NewPressure(tt.unit, 1)
+			if got, want := one.ToPascal(), measurements.FromPascal(tt.base); !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("ToPascal() = %v, want %v", got, want)
+			}
+			if got := one.ToPascal().To(tt.unit); !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ToPascal().To() = %v, want %v", got, one)
+			}
+			got, err := measurements.ParsePressure(one.String())
+			if err != nil {
+				t.Fatalf("ParsePressure() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ParsePressure() = %v, want %v", got, one)
+			}
+		})
+	}
+}
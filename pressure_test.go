@@ -1,6 +1,7 @@
 package measurements_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -39,7 +40,7 @@ func Test_pressure_ToTorr(t *testing.T) {
 				unit:  measurements.Pascal,
 				value: 1333.22,
 			},
-			want: measurements.FromTorr(10.02),
+			want: measurements.FromTorr(10),
 		},
 		{
 			name: "PoundForcePerSquareInch to Torr",
@@ -129,7 +130,7 @@ func Test_pressure_ToPascal(t *testing.T) {
 				unit:  measurements.Torr,
 				value: 0.0750062,
 			},
-			want: measurements.FromPascal(9.98),
+			want: measurements.FromPascal(10),
 		},
 		{
 			name: "Bar to Pascal",
@@ -218,3 +219,242 @@ func Test_pressure_ToPoundForcePerSquareInch(t *testing.T) {
 		})
 	}
 }
+
+func Test_pressure_gauge(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurements.Pressure
+		unit measurements.PressureUnit
+		want string
+	}{
+		{
+			name: "psig to psia",
+			m:    measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 30),
+			unit: measurements.PoundForcePerSquareInch,
+			want: "44.70 psi",
+		},
+		{
+			name: "barg to Pa",
+			m:    measurements.NewPressure(measurements.BarGauge, 2),
+			unit: measurements.Pascal,
+			want: "301325.00 Pa",
+		},
+		{
+			name: "Vacuum",
+			m:    measurements.FromPascal(0),
+			unit: measurements.BarGauge,
+			want: "-1.01 barg",
+		},
+		{
+			name: "psig to barg",
+			m:    measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 14.5037738),
+			unit: measurements.BarGauge,
+			want: "1.00 barg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.To(tt.unit); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("To() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ToAbsolute(t *testing.T) {
+	tests := []struct {
+		name       string
+		m          measurements.Pressure
+		unit       measurements.PressureUnit
+		atmosphere measurements.Pressure
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "Standard atmosphere",
+			m:          measurements.NewPressure(measurements.BarGauge, 1),
+			unit:       measurements.Bar,
+			atmosphere: measurements.StandardAtmosphere,
+			want:       "2.01 bar",
+		},
+		{
+			name:       "Local barometric pressure",
+			m:          measurements.NewPressure(measurements.BarGauge, 1),
+			unit:       measurements.Pascal,
+			atmosphere: measurements.NewPressure(measurements.Bar, 0.95),
+			want:       "195000.00 Pa",
+		},
+		{
+			name:       "Already absolute",
+			m:          measurements.FromBar(1),
+			unit:       measurements.Pascal,
+			atmosphere: measurements.NewPressure(measurements.Bar, 0.95),
+			want:       "100000.00 Pa",
+		},
+		{
+			name:       "Differential",
+			m:          measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 5),
+			unit:       measurements.PoundForcePerSquareInch,
+			atmosphere: measurements.StandardAtmosphere,
+			wantErr:    true,
+		},
+		{
+			name:       "Gauge target",
+			m:          measurements.FromBar(1),
+			unit:       measurements.BarGauge,
+			atmosphere: measurements.StandardAtmosphere,
+			wantErr:    true,
+		},
+		{
+			name:       "Gauge atmosphere",
+			m:          measurements.NewPressure(measurements.BarGauge, 1),
+			unit:       measurements.Bar,
+			atmosphere: measurements.NewPressure(measurements.BarGauge, 0),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := measurements.ToAbsolute(tt.m, tt.unit, tt.atmosphere)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToAbsolute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("ToAbsolute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ToGauge(t *testing.T) {
+	got, err := measurements.ToGauge(measurements.FromPoundForcePerSquareInch(44.7), measurements.PoundForcePerSquareInchGauge, measurements.FromPoundForcePerSquareInch(14.2))
+	if err != nil {
+		t.Fatalf("ToGauge() error = %v", err)
+	}
+	if want := "30.50 psig"; got.String() != want {
+		t.Errorf("ToGauge() = %v, want %v", got, want)
+	}
+	if _, err := measurements.ToGauge(measurements.FromBar(1), measurements.Bar, measurements.StandardAtmosphere); err == nil {
+		t.Error("ToGauge() error = nil for an absolute unit")
+	}
+}
+
+func Test_PressureUnit_Reference(t *testing.T) {
+	tests := []struct {
+		unit measurements.PressureUnit
+		want string
+	}{
+		{unit: measurements.PoundForcePerSquareInch, want: "absolute"},
+		{unit: measurements.PoundForcePerSquareInchGauge, want: "gauge"},
+		{unit: measurements.BarGauge, want: "gauge"},
+		{unit: measurements.PoundForcePerSquareInchDifferential, want: "differential"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			if got := tt.unit.Reference().String(); got != tt.want {
+				t.Errorf("Reference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParsePressure_reference(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "32 psig", want: "32.00 psig"},
+		{input: "32 psia", want: "32.00 psi"},
+		{input: "2.5 barg", want: "2.50 barg"},
+		{input: "1 bara", want: "1.00 bar"},
+		{input: "-3 psid", want: "-3.00 psid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := measurements.ParsePressure(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePressure() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParsePressure() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pressure_extendedUnits(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurements.Pressure
+		unit measurements.PressureUnit
+		want string
+	}{
+		{name: "Atmosphere to mmHg", m: measurements.FromAtmosphere(1), unit: measurements.MillimetreOfMercury, want: "760.00 mmHg"},
+		{name: "Atmosphere to inHg", m: measurements.FromAtmosphere(1), unit: measurements.InchOfMercury, want: "29.92 inHg"},
+		{name: "Atmosphere to hPa", m: measurements.FromAtmosphere(1), unit: measurements.Hectopascal, want: "1013.25 hPa"},
+		{name: "Millibar to hPa", m: measurements.FromMillibar(1013.25), unit: measurements.Hectopascal, want: "1013.25 hPa"},
+		{name: "Technical atmosphere to kPa", m: measurements.FromTechnicalAtmosphere(1), unit: measurements.Kilopascal, want: "98.07 kPa"},
+		{name: "cmH2O to inH2O", m: measurements.FromCentimetreOfWater(2.54), unit: measurements.InchOfWater, want: "1.00 inH2O"},
+		{name: "ksi to MPa", m: measurements.FromKilopoundForcePerSquareInch(1), unit: measurements.Megapascal, want: "6.89 MPa"},
+		{name: "mmHg is not Torr", m: measurements.FromMillimetreOfMercury(1e6), unit: measurements.Torr, want: "1000000.14 Torr"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.To(tt.unit); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("To() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParsePressure_extendedUnits(t *testing.T) {
+	tests := []struct {
+		input string
+		want  measurements.PressureUnit
+	}{
+		{input: "1 atm", want: measurements.Atmosphere},
+		{input: "1 at", want: measurements.TechnicalAtmosphere},
+		{input: "120 mmHg", want: measurements.MillimetreOfMercury},
+		{input: "29.92 inHg", want: measurements.InchOfMercury},
+		{input: "10 cmH₂O", want: measurements.CentimetreOfWater},
+		{input: "3 inches of water", want: measurements.InchOfWater},
+		{input: "1013 mbar", want: measurements.Millibar},
+		{input: "1013 hPa", want: measurements.Hectopascal},
+		{input: "220 kPa", want: measurements.Kilopascal},
+		{input: "250 MPa", want: measurements.Megapascal},
+		{input: "36 ksi", want: measurements.KilopoundForcePerSquareInch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := measurements.ParsePressure(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePressure() error = %v", err)
+			}
+			if got.Unit() != tt.want {
+				t.Errorf("ParsePressure() unit = %v, want %v", got.Unit(), tt.want)
+			}
+		})
+	}
+
+	if got, err := measurements.WithPrefix(measurements.Pascal, measurements.Kilo); err != nil || got != measurements.Kilopascal {
+		t.Errorf("WithPrefix() = %v, %v, want kPa", got, err)
+	}
+}
+
+func Test_pressure_differential(t *testing.T) {
+	psid := measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 5)
+	for _, unit := range []measurements.PressureUnit{measurements.Pascal, measurements.BarGauge} {
+		if got := psid.To(unit); got.String() != "5.00 psid" {
+			t.Errorf("To(%v) = %v, want 5.00 psid", unit, got)
+		}
+		if _, err := psid.ConvertTo(unit); !errors.Is(err, measurements.ErrDifferential) {
+			t.Errorf("ConvertTo(%v) error = %v, want %v", unit, err, measurements.ErrDifferential)
+		}
+	}
+	if _, err := measurements.FromPascal(1).ConvertTo(measurements.PoundForcePerSquareInchDifferential); !errors.Is(err, measurements.ErrDifferential) {
+		t.Errorf("ConvertTo() error = %v, want %v", err, measurements.ErrDifferential)
+	}
+	if got, err := psid.ConvertTo(measurements.PoundForcePerSquareInchDifferential); err != nil || got.String() != "5.00 psid" {
+		t.Errorf("ConvertTo() = %v, %v, want 5.00 psid", got, err)
+	}
+}
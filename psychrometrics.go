@@ -0,0 +1,128 @@
+package measurements
+
+import "math"
+
+// Relative humidity is in percent throughout. Values below 0 are read as
+// 0, perfectly dry air, and values above 100 as 100, saturated air.
+
+// Magnus formula coefficients of Alduchov and Eskridge (1996), accurate to
+// 0.4% from -40 °C to 50 °C over water.
+const (
+	magnusA = 6.1094 // hPa
+	magnusB = 17.625
+	magnusC = 243.04 // °C
+)
+
+// WaterVapourGasConstant is the specific gas constant of water vapour, in
+// J/(kg·K).
+const WaterVapourGasConstant = 461.5
+
+// SaturationVapourPressure returns the pressure, in hectopascals, of water
+// vapour in equilibrium with liquid water at t.
+func SaturationVapourPressure(t Temperature) Pressure {
+	return FromHectopascal(saturationVapourPressure(t.ToCelsius().Value()))
+}
+
+// VapourPressure returns the partial pressure, in hectopascals, of the
+// water vapour in air at t and relative humidity rh.
+func VapourPressure(t Temperature, rh float64) Pressure {
+	return FromHectopascal(saturationVapourPressure(t.ToCelsius().Value()) * clampHumidity(rh) / 100)
+}
+
+func clampHumidity(rh float64) float64 {
+	return math.Max(0, math.Min(100, rh))
+}
+
+func saturationVapourPressure(celsius float64) float64 {
+	return magnusA * math.Exp(magnusB*celsius/(magnusC+celsius))
+}
+
+// RelativeHumidity returns the relative humidity of air at t whose dew
+// point is dewPoint. A dew point above t gives 100.
+func RelativeHumidity(t, dewPoint Temperature) float64 {
+	return clampHumidity(100 * saturationVapourPressure(dewPoint.ToCelsius().Value()) / saturationVapourPressure(t.ToCelsius().Value()))
+}
+
+// AbsoluteHumidity returns the mass of water vapour, in grams per cubic
+// metre, in air at t and relative humidity rh.
+func AbsoluteHumidity(t Temperature, rh float64) float64 {
+	pascals := VapourPressure(t, rh).ToPascal().Value()
+	return 1000 * pascals / (WaterVapourGasConstant * t.ToKelvin().Value())
+}
+
+// DewPoint returns the temperature, in the unit of t, to which air at t
+// and relative humidity rh must be cooled to saturate. Perfectly dry air
+// never saturates, so an rh of 0 gives negative infinity.
+func DewPoint(t Temperature, rh float64) Temperature {
+	celsius := t.ToCelsius().Value()
+	gamma := math.Log(clampHumidity(rh)/100) + magnusB*celsius/(magnusC+celsius)
+	if math.IsInf(gamma, -1) {
+		return FromCelsius(math.Inf(-1)).To(t.Unit())
+	}
+	return FromCelsius(magnusC * gamma / (magnusB - gamma)).To(t.Unit())
+}
+
+// WetBulb returns the temperature, in the unit of t, of a ventilated wet
+// bulb thermometer in air at t, relative humidity rh and pressure p. It
+// solves the psychrometer equation e = es(Tw) - γp(T - Tw) for Tw.
+func WetBulb(t Temperature, rh float64, p Pressure) Temperature {
+	celsius := t.ToCelsius().Value()
+	hPa := p.ToHectopascal().Value()
+	e := saturationVapourPressure(celsius) * clampHumidity(rh) / 100
+
+	// The wet bulb lies below the dry bulb, and the psychrometer equation is
+	// monotonic in Tw. Even perfectly dry air has a wet bulb well above
+	// -100 °C.
+	lo, hi := math.Min(-100, celsius), celsius
+	for i := 0; i < 100 && hi-lo > 1e-9; i++ {
+		tw := (lo + hi) / 2
+		gamma := 0.00066 * (1 + 0.00115*tw)
+		if saturationVapourPressure(tw)-gamma*hPa*(celsius-tw) > e {
+			hi = tw
+		} else {
+			lo = tw
+		}
+	}
+	return FromCelsius((lo + hi) / 2).To(t.Unit())
+}
+
+// HeatIndex returns how hot air at t and relative humidity rh feels, in
+// the unit of t, by the regression of Rothfusz (1990) with the
+// adjustments of the US National Weather Service.
+func HeatIndex(t Temperature, rh float64) Temperature {
+	f := t.ToFahrenheit().Value()
+	rh = clampHumidity(rh)
+	hi := 0.5 * (f + 61 + (f-68)*1.2 + rh*0.094)
+	if (hi+f)/2 >= 80 {
+		hi = -42.379 + 2.04901523*f + 10.14333127*rh - 0.22475541*f*rh -
+			0.00683783*f*f - 0.05481717*rh*rh + 0.00122874*f*f*rh +
+			0.00085282*f*rh*rh - 0.00000199*f*f*rh*rh
+		switch {
+		case rh < 13 && f >= 80 && f <= 112:
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(f-95))/17)
+		case rh > 85 && f >= 80 && f <= 87:
+			hi += (rh - 85) / 10 * (87 - f) / 5
+		}
+	}
+	return FromFahrenheit(hi).To(t.Unit())
+}
+
+// Humidex returns the Canadian humidex of air at t whose dew point is
+// dewPoint, as a temperature in the unit of t.
+func Humidex(t, dewPoint Temperature) Temperature {
+	e := 6.11 * math.Exp(5417.7530*(1/273.16-1/dewPoint.ToKelvin().Value()))
+	return FromCelsius(t.ToCelsius().Value() + 0.5555*(e-10)).To(t.Unit())
+}
+
+// WindChill returns how cold air at t feels in a wind of windSpeed km/h,
+// in the unit of t, by the index used in Canada and the United States.
+// The index is only defined at or below 10 °C and above 4.8 km/h; outside
+// that t is returned unchanged.
+func WindChill(t Temperature, windSpeed float64) Temperature {
+	celsius := t.ToCelsius().Value()
+	if celsius > 10 || windSpeed <= 4.8 {
+		return t
+	}
+	v := math.Pow(windSpeed, 0.16)
+	return FromCelsius(13.12 + 0.6215*celsius - 11.37*v + 0.3965*celsius*v).To(t.Unit())
+}
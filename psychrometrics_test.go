@@ -0,0 +1,160 @@
+package measurements_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_DewPoint(t *testing.T) {
+	tests := []struct {
+		name string
+		t    measurements.Temperature
+		rh   float64
+		want string
+	}{
+		{name: "Mild", t: measurements.FromCelsius(25), rh: 60, want: "16.70 °C"},
+		{name: "Warm", t: measurements.FromCelsius(30), rh: 50, want: "18.45 °C"},
+		{name: "Saturated", t: measurements.FromCelsius(10), rh: 100, want: "10.00 °C"},
+		{name: "Fahrenheit", t: measurements.FromFahrenheit(86), rh: 50, want: "65.20 °F"},
+		{name: "Dry", t: measurements.FromCelsius(25), rh: 0, want: "-Inf °C"},
+		{name: "Negative", t: measurements.FromCelsius(25), rh: -10, want: "-Inf °C"},
+		{name: "Supersaturated", t: measurements.FromCelsius(25), rh: 150, want: "25.00 °C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.DewPoint(tt.t, tt.rh); got.String() != tt.want {
+				t.Errorf("DewPoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Humidity(t *testing.T) {
+	tests := []struct {
+		name      string
+		got       float64
+		want      float64
+		tolerance float64
+	}{
+		{name: "Relative humidity", got: measurements.RelativeHumidity(measurements.FromCelsius(20), measurements.FromCelsius(10)), want: 52.5, tolerance: 0.1},
+		{name: "Relative humidity round trip", got: measurements.RelativeHumidity(measurements.FromCelsius(25), measurements.DewPoint(measurements.FromCelsius(25), 42)), want: 42, tolerance: 1e-9},
+		{name: "Relative humidity supersaturated", got: measurements.RelativeHumidity(measurements.FromCelsius(10), measurements.FromCelsius(20)), want: 100, tolerance: 0},
+		{name: "Vapour pressure above 100%", got: measurements.VapourPressure(measurements.FromCelsius(20), 150).ToHectopascal().Value(), want: measurements.SaturationVapourPressure(measurements.FromCelsius(20)).ToHectopascal().Value(), tolerance: 0},
+		{name: "Absolute humidity saturated", got: measurements.AbsoluteHumidity(measurements.FromCelsius(20), 100), want: 17.3, tolerance: 0.1},
+		{name: "Absolute humidity", got: measurements.AbsoluteHumidity(measurements.FromCelsius(30), 50), want: 15.2, tolerance: 0.1},
+		{name: "Saturation vapour pressure", got: measurements.SaturationVapourPressure(measurements.FromCelsius(20)).ToHectopascal().Value(), want: 23.39, tolerance: 0.1},
+		{name: "Vapour pressure", got: measurements.VapourPressure(measurements.FromCelsius(20), 50).ToPascal().Value(), want: 1169, tolerance: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > tt.tolerance {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// The expected values are the rounded entries of the published tables: the
+// psychrometric chart at sea level for the wet bulb, the US National Weather
+// Service for the heat index and Environment Canada for the humidex and wind
+// chill.
+func Test_ApparentTemperature(t *testing.T) {
+	tests := []struct {
+		name string
+		got  measurements.Temperature
+		want measurements.Temperature
+	}{
+		{
+			name: "Wet bulb",
+			got:  measurements.WetBulb(measurements.FromCelsius(20), 50, measurements.FromHectopascal(1013.25)),
+			want: measurements.FromCelsius(14),
+		},
+		{
+			name: "Wet bulb, dry air",
+			got:  measurements.WetBulb(measurements.FromCelsius(30), 40, measurements.FromHectopascal(1013.25)),
+			want: measurements.FromCelsius(20),
+		},
+		{
+			name: "Wet bulb, perfectly dry",
+			got:  measurements.WetBulb(measurements.FromCelsius(25), 0, measurements.FromHectopascal(1013.25)),
+			want: measurements.FromCelsius(9),
+		},
+		{
+			name: "Wet bulb, supersaturated",
+			got:  measurements.WetBulb(measurements.FromCelsius(25), 150, measurements.FromAtmosphere(1)),
+			want: measurements.FromCelsius(25),
+		},
+		{
+			name: "Wet bulb, saturated",
+			got:  measurements.WetBulb(measurements.FromCelsius(25), 100, measurements.FromAtmosphere(1)),
+			want: measurements.FromCelsius(25),
+		},
+		{
+			name: "Heat index",
+			got:  measurements.HeatIndex(measurements.FromFahrenheit(90), 50),
+			want: measurements.FromFahrenheit(95),
+		},
+		{
+			name: "Heat index, hot",
+			got:  measurements.HeatIndex(measurements.FromFahrenheit(100), 40),
+			want: measurements.FromFahrenheit(109),
+		},
+		{
+			name: "Heat index, humid",
+			got:  measurements.HeatIndex(measurements.FromFahrenheit(96), 65),
+			want: measurements.FromFahrenheit(121),
+		},
+		{
+			name: "Heat index, very humid",
+			got:  measurements.HeatIndex(measurements.FromFahrenheit(84), 90),
+			want: measurements.FromFahrenheit(98),
+		},
+		{
+			name: "Heat index, mild",
+			got:  measurements.HeatIndex(measurements.FromFahrenheit(80), 40),
+			want: measurements.FromFahrenheit(80),
+		},
+		{
+			name: "Humidex",
+			got:  measurements.Humidex(measurements.FromCelsius(30), measurements.FromCelsius(15)),
+			want: measurements.FromCelsius(34),
+		},
+		{
+			name: "Humidex, humid",
+			got:  measurements.Humidex(measurements.FromCelsius(35), measurements.FromCelsius(25)),
+			want: measurements.FromCelsius(47),
+		},
+		{
+			name: "Wind chill",
+			got:  measurements.WindChill(measurements.FromCelsius(-10), 20),
+			want: measurements.FromCelsius(-18),
+		},
+		{
+			name: "Wind chill, strong wind",
+			got:  measurements.WindChill(measurements.FromCelsius(-30), 50),
+			want: measurements.FromCelsius(-49),
+		},
+		{
+			name: "Wind chill, calm",
+			got:  measurements.WindChill(measurements.FromCelsius(-10), 3),
+			want: measurements.FromCelsius(-10),
+		},
+		{
+			name: "Wind chill, warm",
+			got:  measurements.WindChill(measurements.FromCelsius(15), 40),
+			want: measurements.FromCelsius(15),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Unit() != tt.want.Unit() {
+				t.Fatalf("unit = %v, want %v", tt.got.Unit(), tt.want.Unit())
+			}
+			if got := math.Round(tt.got.Value()); got != tt.want.Value() {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
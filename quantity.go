@@ -0,0 +1,117 @@
+package measurements
+
+import "fmt"
+
+// Quantity is a value measured in a unit of type U. Conversions between
+// units go through the base unit of U's kind using the factors in the unit
+// registry, so a quantity only needs a table of units to support To,
+// formatting, parsing and every encoding.
+type Quantity[U Unit] struct {
+	unit  U
+	value float64
+}
+
+func NewQuantity[U Unit](unit U, value float64) Quantity[U] {
+	return Quantity[U]{unit: unit, value: value}
+}
+
+func (s Quantity[U]) Unit() U {
+	return s.unit
+}
+
+func (s Quantity[U]) Value() float64 {
+	return s.value
+}
+
+func (s Quantity[U]) String() string {
+	return formatMeasurement(s.value, s.unit, DefaultFormatOptions)
+}
+
+func (s Quantity[U]) Format(f fmt.State, verb rune) {
+	formatState(f, verb, s.value, s.unit, kindConstructor[s.unit.Kind()])
+}
+
+func (s Quantity[U]) measurementUnit() Unit {
+	return s.unit
+}
+
+// To converts the quantity to unit. Units missing from the registry are
+// treated as the first unit of their kind, and a differential pressure is
+// returned unchanged rather than converted to an absolute or gauge unit;
+// use ConvertTo to have both reported instead.
+func (s Quantity[U]) To(unit U) Quantity[U] {
+	if crossesDifferential(s.unit, unit) {
+		return s
+	}
+	from, fromInfo := knownUnit(s.unit)
+	to, toInfo := knownUnit(unit)
+	if Unit(from) == Unit(to) {
+		return Quantity[U]{unit: to, value: s.value}
+	}
+	return Quantity[U]{unit: to, value: toInfo.FromBase(fromInfo.ToBase(s.value))}
+}
+
+// ConvertTo converts the quantity to unit, returning ErrUnknownUnit when
+// either unit is missing from the registry and ErrDifferential when only
+// one of them is a differential pressure.
+func (s Quantity[U]) ConvertTo(unit U) (Quantity[U], error) {
+	value, err := convertValue(unit.Kind(), s.value, s.unit, unit)
+	if err != nil {
+		return Quantity[U]{}, err
+	}
+	return Quantity[U]{unit: unit, value: value}, nil
+}
+
+func (s Quantity[U]) convertedTo(unit Unit, value float64) Measurement {
+	return Quantity[U]{unit: unit.(U), value: value}
+}
+
+func knownUnit[U Unit](unit U) (U, UnitInfo) {
+	if info, ok := lookupUnitInfo(unit.Kind(), unit); ok {
+		return unit, info
+	}
+	info := registeredUnits(unit.Kind())[0]
+	return info.Unit.(U), info
+}
+
+func (s Quantity[U]) MarshalJSON() ([]byte, error) {
+	return marshalMeasurement(s.value, s.unit)
+}
+
+func (s *Quantity[U]) UnmarshalJSON(data []byte) error {
+	var zero U
+	value, info, _, err := unmarshalMeasurement(zero.Kind(), data)
+	if err != nil {
+		return err
+	}
+	s.unit, s.value = info.Unit.(U), value
+	return nil
+}
+
+func (s Quantity[U]) MarshalText() ([]byte, error) {
+	return marshalValueText(&s)
+}
+
+func (s *Quantity[U]) UnmarshalText(text []byte) error {
+	var zero U
+	value, info, err := parseMeasurement(zero.Kind(), string(text))
+	if err != nil {
+		return err
+	}
+	s.unit, s.value = info.Unit.(U), value
+	return nil
+}
+
+func (s Quantity[U]) MarshalBinary() ([]byte, error) {
+	return marshalMeasurementBinary(s.unit.Kind(), &s)
+}
+
+func (s *Quantity[U]) UnmarshalBinary(data []byte) error {
+	var zero U
+	unit, value, err := unmarshalMeasurementBinary(zero.Kind(), data)
+	if err != nil {
+		return err
+	}
+	s.unit, s.value = unit.(U), value
+	return nil
+}
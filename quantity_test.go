@@ -0,0 +1,95 @@
+package measurements_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_ConvertTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (measurements.Measurement, error)
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Mass",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.FromKilogram(1).ConvertTo(measurements.Gram)
+			},
+			want: "1000.00 g",
+		},
+		{
+			name: "Temperature",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.FromCelsius(100).ConvertTo(measurements.Fahrenheit)
+			},
+			want: "212.00 °F",
+		},
+		{
+			name: "Unknown target mass unit",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.FromKilogram(1).ConvertTo(measurements.MassUnit(99))
+			},
+			wantErr: measurements.ErrUnknownUnit,
+		},
+		{
+			name: "Unknown target pressure unit",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.FromBar(1).ConvertTo(measurements.PressureUnit(-1))
+			},
+			wantErr: measurements.ErrUnknownUnit,
+		},
+		{
+			name: "Unknown source temperature unit",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.NewTemperature(measurements.TemperatureUnit(42), 1).ConvertTo(measurements.Kelvin)
+			},
+			wantErr: measurements.ErrUnknownUnit,
+		},
+		{
+			name: "Unknown volume unit to itself",
+			convert: func() (measurements.Measurement, error) {
+				return measurements.NewVolume(measurements.VolumeType(99), 1).ConvertTo(measurements.VolumeType(99))
+			},
+			wantErr: measurements.ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConvertTo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ConvertTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		want  bool
+	}{
+		{name: "Mass", valid: measurements.Stone.IsValid(), want: true},
+		{name: "Unknown mass", valid: measurements.MassUnit(99).IsValid(), want: false},
+		{name: "Pressure", valid: measurements.Torr.IsValid(), want: true},
+		{name: "Negative pressure code", valid: measurements.PressureUnit(-1).IsValid(), want: false},
+		{name: "Temperature", valid: measurements.Kelvin.IsValid(), want: true},
+		{name: "Unknown temperature", valid: measurements.TemperatureUnit(42).IsValid(), want: false},
+		{name: "Volume", valid: measurements.Litre.IsValid(), want: true},
+		{name: "Unknown volume", valid: measurements.VolumeType(99).IsValid(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.valid != tt.want {
+				t.Errorf("IsValid() = %v, want %v", tt.valid, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+var ErrDuplicateUnit = errors.New("measurements: duplicate unit")
+
+// UnitDefinition describes a unit added at run time. Factor and Offset
+// convert a value in the unit to the base unit of its kind:
+// base = value*Factor + Offset.
+type UnitDefinition struct {
+	Symbol   string
+	Singular string
+	Plural   string
+	System   System
+	Factor   float64
+	Offset   float64
+}
+
+// registryMu guards unitRegistry. Registration never modifies a slice in
+// place, so the slice returned by registeredUnits can be read without it.
+var registryMu sync.RWMutex
+
+func registeredUnits(kind Kind) []UnitInfo {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return unitRegistry[kind]
+}
+
+// registerUnit adds def to the units of kind as the next enum value.
+// Built-in units are numbered from zero in table order, so the next value
+// is the number of units.
+func registerUnit(kind Kind, def UnitDefinition) (UnitInfo, error) {
+	if def.Symbol == "" || strings.TrimSpace(def.Symbol) != def.Symbol {
+		return UnitInfo{}, fmt.Errorf("measurements: invalid %s unit symbol %q", kind, def.Symbol)
+	}
+	if def.Factor == 0 || math.IsNaN(def.Factor) || math.IsInf(def.Factor, 0) || math.IsNaN(def.Offset) || math.IsInf(def.Offset, 0) {
+		return UnitInfo{}, fmt.Errorf("measurements: invalid factor for %s unit %q", kind, def.Symbol)
+	}
+	if def.Singular == "" {
+		def.Singular = def.Symbol
+	}
+	if def.Plural == "" {
+		def.Plural = def.Singular
+	}
+
+	return addUnit(UnitInfo{
+		Kind:     kind,
+		Symbol:   def.Symbol,
+		Singular: def.Singular,
+		Plural:   def.Plural,
+		System:   def.System,
+		Factor:   def.Factor,
+		Offset:   def.Offset,
+		name:     def.Symbol,
+	})
+}
+
+// addUnit appends info to the units of its kind, filling in its Unit.
+func addUnit(info UnitInfo) (UnitInfo, error) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	kind := info.Kind
+	units := unitRegistry[kind]
+	if len(units) == 0 {
+		return UnitInfo{}, fmt.Errorf("measurements: unknown kind %d", kind)
+	}
+	for _, other := range units {
+		if other.hasSymbol(info.Symbol) {
+			return UnitInfo{}, fmt.Errorf("%w: %s symbol %q", ErrDuplicateUnit, kind, info.Symbol)
+		}
+		for _, name := range []string{info.Singular, info.Plural} {
+			if strings.EqualFold(other.Singular, name) || strings.EqualFold(other.Plural, name) {
+				return UnitInfo{}, fmt.Errorf("%w: %s name %q", ErrDuplicateUnit, kind, name)
+			}
+		}
+	}
+
+	info.Unit = makeUnit(kind, int32(len(units)))
+	// The full slice expression makes append copy, leaving the slice held by
+	// concurrent readers untouched.
+	unitRegistry[kind] = append(units[:len(units):len(units)], info)
+	return info, nil
+}
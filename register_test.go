@@ -0,0 +1,210 @@
+package measurements_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func registerMassUnit(t *testing.T, def measurements.UnitDefinition) measurements.MassUnit {
+	t.Helper()
+	unit, err := measurements.RegisterMassUnit(def)
+	if err != nil {
+		t.Fatalf("RegisterMassUnit() error = %v", err)
+	}
+	return unit
+}
+
+func Test_RegisterMassUnit(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	hundredweight := registerMassUnit(t, measurements.UnitDefinition{Symbol: "cwt", Singular: "hundredweight", Plural: "hundredweight", System: measurements.Imperial, Factor: 50.80234544})
+	tola := registerMassUnit(t, measurements.UnitDefinition{Symbol: "tola", Singular: "tola", Plural: "tolas", Factor: 0.0116638125})
+	quintal := registerMassUnit(t, measurements.UnitDefinition{Symbol: "q", Singular: "quintal", Plural: "quintals", Factor: 100})
+
+	tests := []struct {
+		name string
+		got  measurements.Mass
+		want string
+	}{
+		{
+			name: "Hundredweight to Pound",
+			got:  measurements.NewMass(hundredweight, 2).ToPound(),
+			want: "224.00 lb",
+		},
+		{
+			name: "Kilogram to Hundredweight",
+			got:  measurements.FromKilogram(508.0234544).To(hundredweight),
+			want: "10.00 cwt",
+		},
+		{
+			name: "Tola to Gram",
+			got:  measurements.NewMass(tola, 1).ToGram(),
+			want: "11.66 g",
+		},
+		{
+			name: "Quintal to Hundredweight",
+			got:  measurements.NewMass(quintal, 50.80234544).To(hundredweight),
+			want: "100.00 cwt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	m, err := measurements.ParseMass("12 hundredweight")
+	if err != nil {
+		t.Fatalf("ParseMass() error = %v", err)
+	}
+	if m.Unit() != hundredweight || m.Value() != 12 {
+		t.Errorf("ParseMass() = %v, want 12 cwt", m)
+	}
+	if got := fmt.Sprintf("%+v", measurements.NewMass(tola, 2)); got != "2.00 tolas" {
+		t.Errorf("Sprintf(%%+v) = %v, want 2.00 tolas", got)
+	}
+	if got, want := hundredweight.String(), "cwt"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func Test_RegisterPressureUnit(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	ftH2O, err := measurements.RegisterPressureUnit(measurements.UnitDefinition{Symbol: "ftH2O", Singular: "foot of water", Plural: "feet of water", Factor: 2989.06692})
+	if err != nil {
+		t.Fatalf("RegisterPressureUnit() error = %v", err)
+	}
+	mmH2O, err := measurements.RegisterPressureUnit(measurements.UnitDefinition{Symbol: "mmH2O", Singular: "millimetre of water", Plural: "millimetres of water", Factor: 9.80665})
+	if err != nil {
+		t.Fatalf("RegisterPressureUnit() error = %v", err)
+	}
+
+	if got, want := measurements.NewPressure(ftH2O, 1).To(mmH2O).String(), "304.80 mmH2O"; got != want {
+		t.Errorf("To() = %v, want %v", got, want)
+	}
+	p, err := measurements.ParsePressure("4 ftH2O")
+	if err != nil {
+		t.Fatalf("ParsePressure() error = %v", err)
+	}
+	if got, want := p.ToPascal().String(), "11956.27 Pa"; got != want {
+		t.Errorf("ToPascal() = %v, want %v", got, want)
+	}
+}
+
+func Test_RegisterVolumeType(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	hogshead, err := measurements.RegisterVolumeType(measurements.UnitDefinition{Symbol: "hhd", Singular: "hogshead", Plural: "hogsheads", System: measurements.USCustomary, Factor: 238.480942392})
+	if err != nil {
+		t.Fatalf("RegisterVolumeType() error = %v", err)
+	}
+
+	if got, want := measurements.NewVolume(hogshead, 1).ToUSLiquidGallon().String(), "63.00 gal"; got != want {
+		t.Errorf("ToUSLiquidGallon() = %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", hogshead), "measurements.VolumeType(12)"; got != want {
+		t.Errorf("GoString() = %v, want %v", got, want)
+	}
+}
+
+func Test_RegisterMassUnit_errors(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	registerMassUnit(t, measurements.UnitDefinition{Symbol: "cwt", Singular: "hundredweight", Factor: 50.80234544})
+
+	tests := []struct {
+		name    string
+		def     measurements.UnitDefinition
+		wantErr error
+	}{
+		{
+			name:    "Built-in symbol",
+			def:     measurements.UnitDefinition{Symbol: "kg", Factor: 1},
+			wantErr: measurements.ErrDuplicateUnit,
+		},
+		{
+			name:    "Registered symbol",
+			def:     measurements.UnitDefinition{Symbol: "cwt", Singular: "short hundredweight", Factor: 45.359237},
+			wantErr: measurements.ErrDuplicateUnit,
+		},
+		{
+			name:    "Registered name",
+			def:     measurements.UnitDefinition{Symbol: "lcwt", Singular: "Hundredweight", Factor: 50.80234544},
+			wantErr: measurements.ErrDuplicateUnit,
+		},
+		{
+			name: "Empty symbol",
+			def:  measurements.UnitDefinition{Factor: 1},
+		},
+		{
+			name: "Zero factor",
+			def:  measurements.UnitDefinition{Symbol: "zero"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unit, err := measurements.RegisterMassUnit(tt.def)
+			if err == nil {
+				t.Fatal("RegisterMassUnit() error = nil")
+			}
+			if unit != measurements.InvalidMassUnit || unit.IsValid() {
+				t.Errorf("RegisterMassUnit() = %#v, want InvalidMassUnit", unit)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("RegisterMassUnit() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_RegisterMassUnit_binary(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	hundredweight := registerMassUnit(t, measurements.UnitDefinition{Symbol: "cwt", Singular: "hundredweight", Factor: 50.80234544})
+	m := measurements.NewMass(hundredweight, 2)
+	if _, err := (measurements.MassValue{Mass: m}).MarshalBinary(); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("MarshalBinary() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+	var buf bytes.Buffer
+	if err := measurements.NewEncoder(&buf, measurements.MassKind).WriteBlock(hundredweight, []float64{2}); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("WriteBlock() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
+
+func Test_RegisterMassUnit_concurrent(t *testing.T) {
+	measurements.KeepUnits(t)
+
+	const n = 20
+	var wg sync.WaitGroup
+	units := make([]measurements.MassUnit, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			unit, err := measurements.RegisterMassUnit(measurements.UnitDefinition{Symbol: fmt.Sprintf("u%d", i), Factor: float64(i + 1)})
+			if err != nil {
+				t.Errorf("RegisterMassUnit() error = %v", err)
+			}
+			units[i] = unit
+			_ = measurements.FromKilogram(1).To(unit).String()
+		}(i)
+	}
+	wg.Wait()
+
+	seen := map[measurements.MassUnit]bool{}
+	for _, unit := range units {
+		if seen[unit] {
+			t.Errorf("unit %d registered twice", unit)
+		}
+		seen[unit] = true
+	}
+}
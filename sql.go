@@ -0,0 +1,162 @@
+package measurements
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// ColumnFormat selects how a measurement is stored in a database column.
+type ColumnFormat int32
+
+const (
+	// TextColumn stores the value and unit together, such as "1.5 kg".
+	TextColumn ColumnFormat = iota
+	// FloatColumn stores the value converted to the base unit of its kind,
+	// such as kilograms for Mass.
+	FloatColumn
+)
+
+// MassColumn implements sql.Scanner and driver.Valuer for a Mass. A nil
+// Mass is stored as NULL.
+type MassColumn struct {
+	Mass   Mass
+	Format ColumnFormat
+}
+
+// PressureColumn implements sql.Scanner and driver.Valuer for a Pressure.
+// A nil Pressure is stored as NULL.
+type PressureColumn struct {
+	Pressure Pressure
+	Format   ColumnFormat
+}
+
+// VolumeColumn implements sql.Scanner and driver.Valuer for a Volume. A nil
+// Volume is stored as NULL.
+type VolumeColumn struct {
+	Volume Volume
+	Format ColumnFormat
+}
+
+// TemperatureColumn implements sql.Scanner and driver.Valuer for a
+// Temperature. A nil Temperature is stored as NULL.
+type TemperatureColumn struct {
+	Temperature Temperature
+	Format      ColumnFormat
+}
+
+func columnValue(kind Kind, m Measurement, format ColumnFormat) (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	switch format {
+	case TextColumn:
+		return compactString(m), nil
+	case FloatColumn:
+		info, ok := lookupUnitInfo(kind, m.measurementUnit())
+		if !ok {
+			return nil, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, m.measurementUnit())
+		}
+		return info.ToBase(m.Value()), nil
+	default:
+		return nil, fmt.Errorf("measurements: unknown column format %d", format)
+	}
+}
+
+// scanColumn reads a numeric column as a value in the base unit of kind, and
+// a text column either as "value unit" or, when it holds only a number, as
+// a value in the base unit.
+func scanColumn(kind Kind, src interface{}) (float64, UnitInfo, bool, error) {
+	base, _ := BaseUnit(kind)
+
+	switch src := src.(type) {
+	case nil:
+		return 0, UnitInfo{}, false, nil
+	case float64:
+		return src, base, true, nil
+	case int64:
+		return float64(src), base, true, nil
+	case []byte:
+		return scanText(kind, base, string(src))
+	case string:
+		return scanText(kind, base, src)
+	default:
+		return 0, UnitInfo{}, false, fmt.Errorf("measurements: cannot scan %T into %s", src, kind)
+	}
+}
+
+func scanText(kind Kind, base UnitInfo, s string) (float64, UnitInfo, bool, error) {
+	if value, err := strconv.ParseFloat(s, 64); err == nil {
+		return value, base, true, nil
+	}
+	value, info, err := parseMeasurement(kind, s)
+	if err != nil {
+		return 0, UnitInfo{}, false, err
+	}
+	return value, info, true, nil
+}
+
+func (s MassColumn) Value() (driver.Value, error) {
+	return columnValue(MassKind, s.Mass, s.Format)
+}
+
+func (s *MassColumn) Scan(src interface{}) error {
+	value, info, ok, err := scanColumn(MassKind, src)
+	if err != nil {
+		return err
+	}
+	s.Mass = nil
+	if ok {
+		s.Mass = NewMass(info.Unit.(MassUnit), value)
+	}
+	return nil
+}
+
+func (s PressureColumn) Value() (driver.Value, error) {
+	return columnValue(PressureKind, s.Pressure, s.Format)
+}
+
+func (s *PressureColumn) Scan(src interface{}) error {
+	value, info, ok, err := scanColumn(PressureKind, src)
+	if err != nil {
+		return err
+	}
+	s.Pressure = nil
+	if ok {
+		s.Pressure = NewPressure(info.Unit.(PressureUnit), value)
+	}
+	return nil
+}
+
+func (s VolumeColumn) Value() (driver.Value, error) {
+	return columnValue(VolumeKind, s.Volume, s.Format)
+}
+
+func (s *VolumeColumn) Scan(src interface{}) error {
+	value, info, ok, err := scanColumn(VolumeKind, src)
+	if err != nil {
+		return err
+	}
+	s.Volume = nil
+	if ok {
+		s.Volume = NewVolume(info.Unit.(VolumeType), value)
+	}
+	return nil
+}
+
+func (s TemperatureColumn) Value() (driver.Value, error) {
+	return columnValue(TemperatureKind, s.Temperature, s.Format)
+}
+
+func (s *TemperatureColumn) Scan(src interface{}) error {
+	value, info, ok, err := scanColumn(TemperatureKind, src)
+	if err != nil {
+		return err
+	}
+	s.Temperature = nil
+	if ok {
+		s.Temperature = NewTemperature(info.Unit.(TemperatureUnit), value)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package measurements_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+// fakeDriver is an in-memory driver with a single table: INSERT appends its
+// arguments as a row and SELECT returns every row.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+type fakeConn struct{ driver *fakeDriver }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("measurementsfake", fake)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fake: transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(s.query, "INSERT"):
+		d.rows = append(d.rows, args)
+	case strings.HasPrefix(s.query, "DELETE"):
+		d.rows = nil
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rows := make([][]driver.Value, len(d.rows))
+	copy(rows, d.rows)
+	return &fakeRows{rows: rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	columns := make([]string, len(r.rows[0]))
+	for i := range columns {
+		columns[i] = string(rune('a' + i))
+	}
+	return columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("measurementsfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("DELETE"); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func Test_Column_RoundTrip(t *testing.T) {
+	db := openFakeDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("INSERT",
+		measurements.MassColumn{Mass: measurements.FromPound(2), Format: measurements.FloatColumn},
+		measurements.PressureColumn{Pressure: measurements.FromBar(1.5)},
+		measurements.VolumeColumn{Volume: measurements.FromMilliliter(250), Format: measurements.FloatColumn},
+		measurements.TemperatureColumn{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		m measurements.MassColumn
+		p measurements.PressureColumn
+		v measurements.VolumeColumn
+		c measurements.TemperatureColumn
+	)
+	if err := db.QueryRow("SELECT").Scan(&m, &p, &v, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := measurements.FromKilogram(0.91); m.Mass.String() != want.String() {
+		t.Errorf("Mass = %v, want %v", m.Mass, want)
+	}
+	if want := measurements.FromBar(1.5); p.Pressure.String() != want.String() {
+		t.Errorf("Pressure = %v, want %v", p.Pressure, want)
+	}
+	if want := measurements.FromLiter(0.25); v.Volume.String() != want.String() {
+		t.Errorf("Volume = %v, want %v", v.Volume, want)
+	}
+	if c.Temperature != nil {
+		t.Errorf("Temperature = %v, want nil", c.Temperature)
+	}
+}
+
+func Test_Column_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		input driver.Valuer
+		want  driver.Value
+	}{
+		{name: "Text", input: measurements.MassColumn{Mass: measurements.FromGram(12.5)}, want: "12.5 g"},
+		{name: "Float", input: measurements.MassColumn{Mass: measurements.FromGram(12.5), Format: measurements.FloatColumn}, want: 0.0125},
+		{name: "Float pressure", input: measurements.PressureColumn{Pressure: measurements.FromBar(2), Format: measurements.FloatColumn}, want: 200000.0},
+		{name: "Float temperature", input: measurements.TemperatureColumn{Temperature: measurements.FromCelsius(0), Format: measurements.FloatColumn}, want: 273.15},
+		{name: "Null", input: measurements.VolumeColumn{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Column_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "Float", src: 101325.0, want: "101325.00 Pa"},
+		{name: "Integer", src: int64(5), want: "5.00 Pa"},
+		{name: "Text", src: []byte("14.7 psi"), want: "14.70 psi"},
+		{name: "Numeric text", src: "100", want: "100.00 Pa"},
+		{name: "Unknown unit", src: "1 kgf/cm2", wantErr: true},
+		{name: "Unsupported type", src: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got measurements.PressureColumn
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Pressure.String() != tt.want {
+				t.Errorf("Scan() = %v, want %v", got.Pressure, tt.want)
+			}
+		})
+	}
+}
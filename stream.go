@@ -0,0 +1,258 @@
+package measurements
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// A stream starts with a header of streamMagic, the stream version and the
+// kind of every measurement in it. It is followed by blocks, each holding
+// the unit once and then the values:
+//
+//	unit     int32
+//	encoding uint8
+//	scale    float64, only for ScaledInt32Block
+//	count    uint32
+//	values   count float64s or int32s
+//
+// All numbers are big-endian. Only built-in units can be written: units
+// registered at run time have no stable enum value.
+var streamMagic = [4]byte{'M', 'E', 'A', 'S'}
+
+const streamVersion = 1
+
+// BlockEncoding selects how the values of a stream block are stored.
+type BlockEncoding uint8
+
+const (
+	// Float64Block stores each value in eight bytes without loss.
+	Float64Block BlockEncoding = iota
+	// ScaledInt32Block stores each value in four bytes as round(value/Scale).
+	ScaledInt32Block
+)
+
+// Encoder writes blocks of measurements of a single kind to a stream.
+type Encoder struct {
+	w    io.Writer
+	kind Kind
+
+	// Encoding and Scale apply to every block written after they are set.
+	Encoding BlockEncoding
+	Scale    float64
+
+	wroteHeader bool
+}
+
+func NewEncoder(w io.Writer, kind Kind) *Encoder {
+	return &Encoder{w: w, kind: kind, Scale: 1}
+}
+
+// Encode writes values, starting a new block whenever the unit changes.
+// The first call writes the stream header even when there are no values,
+// so Encode() with none leaves a valid empty stream.
+func (s *Encoder) Encode(values ...Measurement) error {
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+	for start := 0; start < len(values); {
+		unit := values[start].measurementUnit()
+		end := start
+		block := []float64{}
+		for end < len(values) && values[end].measurementUnit() == unit {
+			block = append(block, values[end].Value())
+			end++
+		}
+		if err := s.WriteBlock(unit, block); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// WriteBlock writes values, all measured in unit, as a single block.
+func (s *Encoder) WriteBlock(unit Unit, values []float64) error {
+	code, ok := unitCode(s.kind, unit)
+	if !ok {
+		return fmt.Errorf("%w: %s %#v", ErrUnknownUnit, s.kind, unit)
+	}
+
+	if err := s.writeHeader(); err != nil {
+		return err
+	}
+
+	var buf []byte
+	switch s.Encoding {
+	case Float64Block:
+		buf = make([]byte, 4+1+4+8*len(values))
+		binary.BigEndian.PutUint32(buf, uint32(code))
+		buf[4] = byte(Float64Block)
+		binary.BigEndian.PutUint32(buf[5:], uint32(len(values)))
+		for i, value := range values {
+			binary.BigEndian.PutUint64(buf[9+8*i:], math.Float64bits(value))
+		}
+	case ScaledInt32Block:
+		if s.Scale <= 0 {
+			return fmt.Errorf("measurements: invalid block scale %v", s.Scale)
+		}
+		buf = make([]byte, 4+1+8+4+4*len(values))
+		binary.BigEndian.PutUint32(buf, uint32(code))
+		buf[4] = byte(ScaledInt32Block)
+		binary.BigEndian.PutUint64(buf[5:], math.Float64bits(s.Scale))
+		binary.BigEndian.PutUint32(buf[13:], uint32(len(values)))
+		for i, value := range values {
+			scaled := math.Round(value / s.Scale)
+			if math.IsNaN(scaled) || scaled < math.MinInt32 || scaled > math.MaxInt32 {
+				return fmt.Errorf("measurements: %v does not fit a scaled int32 block with scale %v", value, s.Scale)
+			}
+			binary.BigEndian.PutUint32(buf[17+4*i:], uint32(int32(scaled)))
+		}
+	default:
+		return fmt.Errorf("measurements: unknown block encoding %d", s.Encoding)
+	}
+
+	_, err := s.w.Write(buf)
+	return err
+}
+
+func (s *Encoder) writeHeader() error {
+	if s.wroteHeader {
+		return nil
+	}
+	header := []byte{streamMagic[0], streamMagic[1], streamMagic[2], streamMagic[3], streamVersion, byte(s.kind)}
+	if _, err := s.w.Write(header); err != nil {
+		return err
+	}
+	s.wroteHeader = true
+	return nil
+}
+
+// Decoder reads blocks of measurements written by an Encoder.
+type Decoder struct {
+	r          io.Reader
+	kind       Kind
+	readHeader bool
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Kind returns the kind of measurement in the stream.
+func (s *Decoder) Kind() (Kind, error) {
+	if err := s.header(); err != nil {
+		return 0, err
+	}
+	return s.kind, nil
+}
+
+// Decode reads the next block as measurements. It returns io.EOF when the
+// stream has no more blocks.
+func (s *Decoder) Decode() ([]Measurement, error) {
+	unit, values, err := s.ReadBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	measurements := make([]Measurement, len(values))
+	for i, value := range values {
+		measurements[i] = newMeasurement(s.kind, unit, value)
+	}
+	return measurements, nil
+}
+
+// ReadBlock reads the unit and values of the next block. It returns io.EOF
+// when the stream has no more blocks.
+func (s *Decoder) ReadBlock() (Unit, []float64, error) {
+	if err := s.header(); err != nil {
+		return nil, nil, err
+	}
+
+	var head [5]byte
+	if _, err := io.ReadFull(s.r, head[:]); err != nil {
+		return nil, nil, err
+	}
+	code := int32(binary.BigEndian.Uint32(head[:]))
+	unit, ok := unitFromCode(s.kind, code)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s %d", ErrUnknownUnit, s.kind, code)
+	}
+
+	encoding := BlockEncoding(head[4])
+	scale := 1.0
+	switch encoding {
+	case Float64Block:
+	case ScaledInt32Block:
+		var b [8]byte
+		if _, err := io.ReadFull(s.r, b[:]); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		scale = math.Float64frombits(binary.BigEndian.Uint64(b[:]))
+	default:
+		return nil, nil, fmt.Errorf("measurements: unknown block encoding %d", encoding)
+	}
+
+	var b [4]byte
+	if _, err := io.ReadFull(s.r, b[:]); err != nil {
+		return nil, nil, unexpectedEOF(err)
+	}
+	count := binary.BigEndian.Uint32(b[:])
+
+	size := 8
+	if encoding == ScaledInt32Block {
+		size = 4
+	}
+
+	// count comes from the stream, so the values are read in chunks rather
+	// than allocated up front: a corrupt count runs out of input instead of
+	// memory.
+	values := make([]float64, 0, min(int(count), blockChunk))
+	buf := make([]byte, blockChunk*size)
+	for remaining := int(count); remaining > 0; {
+		n := min(remaining, blockChunk)
+		if _, err := io.ReadFull(s.r, buf[:n*size]); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+		for i := 0; i < n; i++ {
+			if encoding == ScaledInt32Block {
+				values = append(values, float64(int32(binary.BigEndian.Uint32(buf[4*i:])))*scale)
+			} else {
+				values = append(values, math.Float64frombits(binary.BigEndian.Uint64(buf[8*i:])))
+			}
+		}
+		remaining -= n
+	}
+	return unit, values, nil
+}
+
+// blockChunk is the number of values ReadBlock reads at a time.
+const blockChunk = 1024
+
+func (s *Decoder) header() error {
+	if s.readHeader {
+		return nil
+	}
+
+	var header [6]byte
+	if _, err := io.ReadFull(s.r, header[:]); err != nil {
+		return unexpectedEOF(err)
+	}
+	if [4]byte{header[0], header[1], header[2], header[3]} != streamMagic {
+		return fmt.Errorf("%w: not a measurement stream", ErrSyntax)
+	}
+	if header[4] != streamVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, header[4])
+	}
+	s.kind = Kind(header[5])
+	s.readHeader = true
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,176 @@
+package measurements_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Encoder_Decoder(t *testing.T) {
+	var buf bytes.Buffer
+	enc := measurements.NewEncoder(&buf, measurements.TemperatureKind)
+	err := enc.Encode(
+		measurements.FromCelsius(21.5),
+		measurements.FromCelsius(22),
+		measurements.FromKelvin(300),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enc.Encoding = measurements.ScaledInt32Block
+	enc.Scale = 0.01
+	if err := enc.WriteBlock(measurements.Fahrenheit, []float64{70.123, -40}); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := measurements.NewDecoder(&buf)
+	if kind, err := dec.Kind(); err != nil || kind != measurements.TemperatureKind {
+		t.Fatalf("Kind() = %v, %v, want %v", kind, err, measurements.TemperatureKind)
+	}
+
+	want := [][]string{
+		{"21.50 °C", "22.00 °C"},
+		{"300.00 K"},
+		{"70.12 °F", "-40.00 °F"},
+	}
+	for i, block := range want {
+		got, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("Decode() block %d: %v", i, err)
+		}
+		if len(got) != len(block) {
+			t.Fatalf("Decode() block %d has %d values, want %d", i, len(got), len(block))
+		}
+		for j := range block {
+			if got[j].String() != block[j] {
+				t.Errorf("Decode() block %d value %d = %v, want %v", i, j, got[j], block[j])
+			}
+			if _, ok := got[j].(measurements.Temperature); !ok {
+				t.Errorf("Decode() block %d value %d is %T, want Temperature", i, j, got[j])
+			}
+		}
+	}
+
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Errorf("Decode() at end error = %v, want %v", err, io.EOF)
+	}
+}
+
+func Test_Encoder_ScaledInt32(t *testing.T) {
+	var buf bytes.Buffer
+	enc := measurements.NewEncoder(&buf, measurements.PressureKind)
+	enc.Encoding = measurements.ScaledInt32Block
+	enc.Scale = 10
+
+	values := []float64{101325, 99870, 102010}
+	if err := enc.WriteBlock(measurements.Pascal, values); err != nil {
+		t.Fatal(err)
+	}
+	if want := 6 + 4 + 1 + 8 + 4 + 4*len(values); buf.Len() != want {
+		t.Errorf("encoded length = %d, want %d", buf.Len(), want)
+	}
+
+	unit, got, err := measurements.NewDecoder(&buf).ReadBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unit != measurements.Pascal {
+		t.Errorf("ReadBlock() unit = %v, want %v", unit, measurements.Pascal)
+	}
+	for i := range values {
+		if math.Abs(got[i]-values[i]) > 5 {
+			t.Errorf("ReadBlock() value %d = %v, want %v", i, got[i], values[i])
+		}
+	}
+
+	if err := enc.WriteBlock(measurements.Pascal, []float64{1e12}); err == nil {
+		t.Error("WriteBlock() expected an overflow error")
+	}
+}
+
+func Test_Encoder_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := measurements.NewEncoder(&buf, measurements.PressureKind).Encode(); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := measurements.NewDecoder(&buf)
+	if kind, err := dec.Kind(); err != nil || kind != measurements.PressureKind {
+		t.Fatalf("Kind() = %v, %v, want %v", kind, err, measurements.PressureKind)
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Errorf("Decode() error = %v, want %v", err, io.EOF)
+	}
+}
+
+// Like the binary encoding, blocks store the enum value of the unit, so
+// these bytes pin the order of the units in quantities.json.
+func Test_Encoder_golden(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  measurements.Kind
+		input measurements.Measurement
+		want  string
+	}{
+		{name: "Mass", kind: measurements.MassKind, input: measurements.FromSlug(2.5), want: "4d454153 01 00 00000011 00 00000001 4004000000000000"},
+		{name: "Pressure", kind: measurements.PressureKind, input: measurements.FromKilopoundForcePerSquareInch(2.5), want: "4d454153 01 01 00000011 00 00000001 4004000000000000"},
+		{name: "Volume", kind: measurements.VolumeKind, input: measurements.FromImperialGallon(2.5), want: "4d454153 01 02 0000000b 00 00000001 4004000000000000"},
+		{name: "Temperature", kind: measurements.TemperatureKind, input: measurements.FromNewton(2.5), want: "4d454153 01 03 00000007 00 00000001 4004000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := measurements.NewEncoder(&buf, tt.kind).Encode(tt.input); err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(buf.Bytes()); got != strings.ReplaceAll(tt.want, " ", "") {
+				t.Errorf("Encode() = %s, want %s", got, tt.want)
+			}
+
+			want, _ := hex.DecodeString(strings.ReplaceAll(tt.want, " ", ""))
+			got, err := measurements.NewDecoder(bytes.NewReader(want)).Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || got[0].String() != tt.input.String() {
+				t.Errorf("Decode() = %v, want [%v]", got, tt.input)
+			}
+		})
+	}
+}
+
+func Test_Encoder_WrongKind(t *testing.T) {
+	enc := measurements.NewEncoder(io.Discard, measurements.MassKind)
+	if err := enc.Encode(measurements.FromLiter(1)); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("Encode() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
+
+func Test_Decoder_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		wantErr error
+	}{
+		{name: "Bad magic", input: []byte("JUNK\x01\x00"), wantErr: measurements.ErrSyntax},
+		{name: "Future version", input: []byte("MEAS\x09\x00"), wantErr: measurements.ErrUnsupportedVersion},
+		{name: "Truncated", input: []byte("MEA"), wantErr: io.ErrUnexpectedEOF},
+		{name: "Huge count", input: []byte("MEAS\x01\x00\x00\x00\x00\x00\x00\xff\xff\xff\xf0\x00\x00\x00"), wantErr: io.ErrUnexpectedEOF},
+		{name: "Truncated values", input: []byte("MEAS\x01\x00\x00\x00\x00\x00\x00\x00\x00\x04\x01\x00\x00\x00\x00\x00\x00\x00"), wantErr: io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := measurements.NewDecoder(bytes.NewReader(tt.input)).ReadBlock()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ReadBlock() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements
+
+type TemperatureUnit int32
+
+const (
+	Celsius TemperatureUnit = iota
+	Fahrenheit
+	Kelvin
+	Rankine
+	Reaumur
+	Romer
+	Delisle
+	Newton
+)
+
+// InvalidTemperatureUnit is returned by RegisterTemperatureUnit on error. It is
+// never a temperature unit, so IsValid reports false and ConvertTo and the
+// encodings reject it.
+const InvalidTemperatureUnit TemperatureUnit = -1
+
+// TemperatureUnitTypeName and TemperatureUnitTypeValue hold the built-in temperature units only; units
+// added by RegisterTemperatureUnit are found through LookupUnit.
+var TemperatureUnitTypeName = map[TemperatureUnit]string{
+	Celsius:    "C",
+	Fahrenheit: "F",
+	Kelvin:     "K",
+	Rankine:    "R",
+	Reaumur:    "Re",
+	Romer:      "Ro",
+	Delisle:    "De",
+	Newton:     "N",
+}
+
+var TemperatureUnitTypeValue = map[string]TemperatureUnit{
+	"C":  Celsius,
+	"F":  Fahrenheit,
+	"K":  Kelvin,
+	"R":  Rankine,
+	"Re": Reaumur,
+	"Ro": Romer,
+	"De": Delisle,
+	"N":  Newton,
+}
+var temperatureUnits = []UnitInfo{
+	{Unit: Celsius, Kind: TemperatureKind, Symbol: "°C", Singular: "degree Celsius", Plural: "degrees Celsius", System: SI, Factor: 1, Offset: 273.15, name: "C", goName: "Celsius"},
+	{Unit: Fahrenheit, Kind: TemperatureKind, Symbol: "°F", Singular: "degree Fahrenheit", Plural: "degrees Fahrenheit", System: USCustomary, Factor: 5.0 / 9, Offset: 459.67 * 5 / 9, name: "F", goName: "Fahrenheit"},
+	{Unit: Kelvin, Kind: TemperatureKind, Symbol: "K", Singular: "kelvin", Plural: "kelvins", System: SI, Base: true, Prefixable: true, Factor: 1, name: "K", goName: "Kelvin"},
+	{Unit: Rankine, Kind: TemperatureKind, Symbol: "°R", Singular: "degree Rankine", Plural: "degrees Rankine", Factor: 5.0 / 9, name: "R", goName: "Rankine"},
+	{Unit: Reaumur, Kind: TemperatureKind, Symbol: "°Ré", Singular: "degree Réaumur", Plural: "degrees Réaumur", Factor: 5.0 / 4, Offset: 273.15, name: "Re", aliases: []string{"°Re", "Ré"}, goName: "Reaumur"},
+	{Unit: Romer, Kind: TemperatureKind, Symbol: "°Rø", Singular: "degree Rømer", Plural: "degrees Rømer", Factor: 40.0 / 21, Offset: 273.15 - 7.5*40/21, name: "Ro", aliases: []string{"°Ro", "Rø"}, goName: "Romer"},
+	{Unit: Delisle, Kind: TemperatureKind, Symbol: "°De", Singular: "degree Delisle", Plural: "degrees Delisle", Factor: -2.0 / 3, Offset: 373.15, name: "De", goName: "Delisle"},
+	{Unit: Newton, Kind: TemperatureKind, Symbol: "°N", Singular: "degree Newton", Plural: "degrees Newton", Factor: 100.0 / 33, Offset: 273.15, name: "N", goName: "Newton"},
+}
+
+func (s TemperatureUnit) Kind() Kind {
+	return TemperatureKind
+}
+
+func (s TemperatureUnit) String() string {
+	return unitInfo(s).name
+}
+
+func (s TemperatureUnit) Symbol() string {
+	return s.Info().Symbol
+}
+
+func (s TemperatureUnit) Singular() string {
+	return s.Info().Singular
+}
+
+func (s TemperatureUnit) Plural() string {
+	return s.Info().Plural
+}
+
+func (s TemperatureUnit) Info() UnitInfo {
+	return unitInfo(s)
+}
+
+func (s TemperatureUnit) GoString() string {
+	return unitGoString(s, "TemperatureUnit", int32(s))
+}
+
+// IsValid reports whether s is a built-in or registered temperature unit.
+func (s TemperatureUnit) IsValid() bool {
+	_, ok := lookupUnitInfo(TemperatureKind, s)
+	return ok
+}
+
+type Temperature interface {
+	Measurement
+
+	Unit() TemperatureUnit
+	Value() float64
+	String() string
+
+	Validate() error
+
+	To(unit TemperatureUnit) Temperature
+	ConvertTo(unit TemperatureUnit) (Temperature, error)
+	ToCelsius() Temperature
+	ToFahrenheit() Temperature
+	ToKelvin() Temperature
+	ToRankine() Temperature
+	ToReaumur() Temperature
+	ToRomer() Temperature
+	ToDelisle() Temperature
+	ToNewton() Temperature
+}
+
+type temperature struct {
+	Quantity[TemperatureUnit]
+}
+
+// RegisterTemperatureUnit adds a temperature unit at run time. The unit works with
+// To, parsing and formatting like the built-in units, but its enum value
+// depends on the order of registration, so it cannot be written by
+// MarshalBinary or an Encoder. On error it returns InvalidTemperatureUnit.
+func RegisterTemperatureUnit(def UnitDefinition) (TemperatureUnit, error) {
+	info, err := registerUnit(TemperatureKind, def)
+	if err != nil {
+		return InvalidTemperatureUnit, err
+	}
+	return info.Unit.(TemperatureUnit), nil
+}
+
+func NewTemperature(unit TemperatureUnit, value float64) Temperature {
+	return &temperature{NewQuantity(unit, value)}
+}
+
+// NewTemperatureChecked is NewTemperature for untrusted values: it returns a
+// *ValueError when value is NaN, infinite or physically impossible.
+func NewTemperatureChecked(unit TemperatureUnit, value float64) (Temperature, error) {
+	m := NewTemperature(unit, value)
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func FromCelsius(value float64) Temperature {
+	return NewTemperature(Celsius, value)
+}
+
+func FromFahrenheit(value float64) Temperature {
+	return NewTemperature(Fahrenheit, value)
+}
+
+func FromKelvin(value float64) Temperature {
+	return NewTemperature(Kelvin, value)
+}
+
+func FromRankine(value float64) Temperature {
+	return NewTemperature(Rankine, value)
+}
+
+func FromReaumur(value float64) Temperature {
+	return NewTemperature(Reaumur, value)
+}
+
+func FromRomer(value float64) Temperature {
+	return NewTemperature(Romer, value)
+}
+
+func FromDelisle(value float64) Temperature {
+	return NewTemperature(Delisle, value)
+}
+
+func FromNewton(value float64) Temperature {
+	return NewTemperature(Newton, value)
+}
+
+func ParseTemperature(s string) (Temperature, error) {
+	value, info, err := parseMeasurement(TemperatureKind, s)
+	if err != nil {
+		return nil, err
+	}
+	return NewTemperature(info.Unit.(TemperatureUnit), value), nil
+}
+
+// BestTemperatureUnit returns the unit that shows m most readably, as chosen
+// by BestUnit.
+func BestTemperatureUnit(m Temperature, opts HumanizeOptions) TemperatureUnit {
+	return BestUnit(m, opts).(TemperatureUnit)
+}
+
+func (s *temperature) To(unit TemperatureUnit) Temperature {
+	return &temperature{s.Quantity.To(unit)}
+}
+
+func (s *temperature) convertedTo(unit Unit, value float64) Measurement {
+	return NewTemperature(unit.(TemperatureUnit), value)
+}
+
+func (s *temperature) ConvertTo(unit TemperatureUnit) (Temperature, error) {
+	q, err := s.Quantity.ConvertTo(unit)
+	if err != nil {
+		return nil, err
+	}
+	return &temperature{q}, nil
+}
+
+func (s *temperature) ToCelsius() Temperature {
+	return s.To(Celsius)
+}
+
+func (s *temperature) ToFahrenheit() Temperature {
+	return s.To(Fahrenheit)
+}
+
+func (s *temperature) ToKelvin() Temperature {
+	return s.To(Kelvin)
+}
+
+func (s *temperature) ToRankine() Temperature {
+	return s.To(Rankine)
+}
+
+func (s *temperature) ToReaumur() Temperature {
+	return s.To(Reaumur)
+}
+
+func (s *temperature) ToRomer() Temperature {
+	return s.To(Romer)
+}
+
+func (s *temperature) ToDelisle() Temperature {
+	return s.To(Delisle)
+}
+
+func (s *temperature) ToNewton() Temperature {
+	return s.To(Newton)
+}
@@ -0,0 +1,78 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_temperature_generated(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.TemperatureUnit
+		// base is one of unit in kelvins.
+		base float64
+	}{
+		{
+			name: "Celsius",
+			unit: measurements.Celsius,
+			base: 1 + 273.15,
+		},
+		{
+			name: "Fahrenheit",
+			unit: measurements.Fahrenheit,
+			base: 5.0/9 + 459.67*5/9,
+		},
+		{
+			name: "Kelvin",
+			unit: measurements.Kelvin,
+			base: 1,
+		},
+		{
+			name: "Rankine",
+			unit: measurements.Rankine,
+			base: 5.0 / 9,
+		},
+		{
+			name: "Reaumur",
+			unit: measurements.Reaumur,
+			base: 5.0/4 + 273.15,
+		},
+		{
+			name: "Romer",
+			unit: measurements.Romer,
+			base: 40.0/21 + 273.15 - 7.5*40/21,
+		},
+		{
+			name: "Delisle",
+			unit: measurements.Delisle,
+			base: -2.0/3 + 373.15,
+		},
+		{
+			name: "Newton",
+			unit: measurements.Newton,
+			base: 100.0/33 + 273.15,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			one := measurements.NewTemperature(tt.unit, 1)
+			if got, want := one.ToKelvin(), measurements.FromKelvin(tt.base); !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("ToKelvin() = %v, want %v", got, want)
+			}
+			if got := one.ToKelvin().To(tt.unit); !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ToKelvin().To() = %v, want %v", got, one)
+			}
+			got, err := measurements.ParseTemperature(one.String())
+			if err != nil {
+				t.Fatalf("ParseTemperature() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ParseTemperature() = %v, want %v", got, one)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package measurements_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -141,3 +142,61 @@ func Test_temperature_ToKelvin(t *testing.T) {
 		})
 	}
 }
+
+func Test_temperature_extendedScales(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurements.Temperature
+		unit measurements.TemperatureUnit
+		want string
+	}{
+		{name: "Boiling point in Rankine", m: measurements.FromCelsius(100), unit: measurements.Rankine, want: "671.67 °R"},
+		{name: "Absolute zero in Rankine", m: measurements.FromKelvin(0), unit: measurements.Rankine, want: "0.00 °R"},
+		{name: "Rankine to Fahrenheit", m: measurements.FromRankine(491.67), unit: measurements.Fahrenheit, want: "32.00 °F"},
+		{name: "Boiling point in Réaumur", m: measurements.FromCelsius(100), unit: measurements.Reaumur, want: "80.00 °Ré"},
+		{name: "Freezing point in Rømer", m: measurements.FromCelsius(0), unit: measurements.Romer, want: "7.50 °Rø"},
+		{name: "Boiling point in Rømer", m: measurements.FromCelsius(100), unit: measurements.Romer, want: "60.00 °Rø"},
+		{name: "Body temperature in Delisle", m: measurements.FromCelsius(37), unit: measurements.Delisle, want: "94.50 °De"},
+		{name: "Freezing point in Delisle", m: measurements.FromCelsius(0), unit: measurements.Delisle, want: "150.00 °De"},
+		{name: "Delisle to Celsius", m: measurements.FromDelisle(90), unit: measurements.Celsius, want: "40.00 °C"},
+		{name: "Boiling point in Newton", m: measurements.FromCelsius(100), unit: measurements.Newton, want: "33.00 °N"},
+		{name: "Newton to Kelvin", m: measurements.FromNewton(0), unit: measurements.Kelvin, want: "273.15 K"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.To(tt.unit); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("To() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ParseTemperature_extendedScales(t *testing.T) {
+	tests := []struct {
+		input string
+		want  measurements.TemperatureUnit
+	}{
+		{input: "500 °R", want: measurements.Rankine},
+		{input: "20 °Ré", want: measurements.Reaumur},
+		{input: "20 °Re", want: measurements.Reaumur},
+		{input: "20 °Rø", want: measurements.Romer},
+		{input: "20 degrees Rømer", want: measurements.Romer},
+		{input: "120 °De", want: measurements.Delisle},
+		{input: "10 °N", want: measurements.Newton},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := measurements.ParseTemperature(tt.input)
+			if err != nil {
+				t.Fatalf("ParseTemperature() error = %v", err)
+			}
+			if got.Unit() != tt.want {
+				t.Errorf("ParseTemperature() unit = %v, want %v", got.Unit(), tt.want)
+			}
+		})
+	}
+
+	if _, err := measurements.NewTemperatureChecked(measurements.Delisle, 600); !errors.Is(err, measurements.ErrBelowAbsoluteZero) {
+		t.Errorf("NewTemperatureChecked() error = %v, want %v", err, measurements.ErrBelowAbsoluteZero)
+	}
+}
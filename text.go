@@ -0,0 +1,145 @@
+package measurements
+
+import "fmt"
+
+func marshalUnitText(kind Kind, unit Unit) ([]byte, error) {
+	if _, ok := lookupUnitInfo(kind, unit); !ok {
+		return nil, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, unit)
+	}
+	return []byte(unit.String()), nil
+}
+
+func unmarshalUnitText(kind Kind, text []byte) (Unit, error) {
+	info, ok := LookupUnit(kind, string(text))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %q", ErrUnknownUnit, kind, text)
+	}
+	return info.Unit, nil
+}
+
+func marshalValueText(m Measurement) ([]byte, error) {
+	if m == nil {
+		return []byte{}, nil
+	}
+	return []byte(compactString(m)), nil
+}
+
+func (s MassUnit) MarshalText() ([]byte, error) {
+	return marshalUnitText(MassKind, s)
+}
+
+func (s *MassUnit) UnmarshalText(text []byte) error {
+	unit, err := unmarshalUnitText(MassKind, text)
+	if err != nil {
+		return err
+	}
+	*s = unit.(MassUnit)
+	return nil
+}
+
+func (s MassValue) MarshalText() ([]byte, error) {
+	return marshalValueText(s.Mass)
+}
+
+func (s *MassValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		s.Mass = nil
+		return nil
+	}
+	m, err := ParseMass(string(text))
+	if err != nil {
+		return err
+	}
+	s.Mass = m
+	return nil
+}
+
+func (s PressureUnit) MarshalText() ([]byte, error) {
+	return marshalUnitText(PressureKind, s)
+}
+
+func (s *PressureUnit) UnmarshalText(text []byte) error {
+	unit, err := unmarshalUnitText(PressureKind, text)
+	if err != nil {
+		return err
+	}
+	*s = unit.(PressureUnit)
+	return nil
+}
+
+func (s PressureValue) MarshalText() ([]byte, error) {
+	return marshalValueText(s.Pressure)
+}
+
+func (s *PressureValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		s.Pressure = nil
+		return nil
+	}
+	p, err := ParsePressure(string(text))
+	if err != nil {
+		return err
+	}
+	s.Pressure = p
+	return nil
+}
+
+func (s VolumeType) MarshalText() ([]byte, error) {
+	return marshalUnitText(VolumeKind, s)
+}
+
+func (s *VolumeType) UnmarshalText(text []byte) error {
+	unit, err := unmarshalUnitText(VolumeKind, text)
+	if err != nil {
+		return err
+	}
+	*s = unit.(VolumeType)
+	return nil
+}
+
+func (s VolumeValue) MarshalText() ([]byte, error) {
+	return marshalValueText(s.Volume)
+}
+
+func (s *VolumeValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		s.Volume = nil
+		return nil
+	}
+	v, err := ParseVolume(string(text))
+	if err != nil {
+		return err
+	}
+	s.Volume = v
+	return nil
+}
+
+func (s TemperatureUnit) MarshalText() ([]byte, error) {
+	return marshalUnitText(TemperatureKind, s)
+}
+
+func (s *TemperatureUnit) UnmarshalText(text []byte) error {
+	unit, err := unmarshalUnitText(TemperatureKind, text)
+	if err != nil {
+		return err
+	}
+	*s = unit.(TemperatureUnit)
+	return nil
+}
+
+func (s TemperatureValue) MarshalText() ([]byte, error) {
+	return marshalValueText(s.Temperature)
+}
+
+func (s *TemperatureValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		s.Temperature = nil
+		return nil
+	}
+	t, err := ParseTemperature(string(text))
+	if err != nil {
+		return err
+	}
+	s.Temperature = t
+	return nil
+}
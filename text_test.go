@@ -0,0 +1,109 @@
+package measurements_test
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Unit_MarshalText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{name: "Mass unit", input: measurements.Pound, want: `"lb"`},
+		{name: "Pressure unit", input: measurements.Pascal, want: `"Pa"`},
+		{name: "Volume type", input: measurements.ImperialGallon, want: `"imp gal"`},
+		{name: "Temperature unit", input: measurements.Fahrenheit, want: `"F"`},
+		{name: "Map key", input: map[measurements.MassUnit]int{measurements.Gram: 1}, want: `{"g":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Unit_MarshalText_Unknown(t *testing.T) {
+	if _, err := measurements.MassUnit(99).MarshalText(); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("MarshalText() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
+
+func Test_Unit_UnmarshalText(t *testing.T) {
+	var config struct {
+		Mass        measurements.MassUnit
+		Pressure    measurements.PressureUnit
+		Volume      measurements.VolumeType
+		Temperature measurements.TemperatureUnit
+	}
+	input := `{"Mass":"oz","Pressure":"psi","Volume":"imperial pints","Temperature":"°F"}`
+	if err := json.Unmarshal([]byte(input), &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.Mass != measurements.Ounce || config.Pressure != measurements.PoundForcePerSquareInch ||
+		config.Volume != measurements.ImperialPint || config.Temperature != measurements.Fahrenheit {
+		t.Errorf("Unmarshal() = %+v", config)
+	}
+
+	var unit measurements.VolumeType
+	if err := unit.UnmarshalText([]byte("hogshead")); !errors.Is(err, measurements.ErrUnknownUnit) {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, measurements.ErrUnknownUnit)
+	}
+}
+
+func Test_Value_MarshalText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{ MarshalText() ([]byte, error) }
+		want  string
+	}{
+		{name: "Mass", input: measurements.MassValue{Mass: measurements.FromGram(0.125)}, want: "0.125 g"},
+		{name: "Pressure", input: measurements.PressureValue{Pressure: measurements.FromBar(2)}, want: "2 bar"},
+		{name: "Volume", input: measurements.VolumeValue{Volume: measurements.FromUSfluidOunce(12)}, want: "12 fl oz"},
+		{name: "Temperature", input: measurements.TemperatureValue{Temperature: measurements.FromKelvin(300)}, want: "300 K"},
+		{name: "Nil", input: measurements.MassValue{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Value_TextVar(t *testing.T) {
+	var limit measurements.PressureValue
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.TextVar(&limit, "limit", measurements.PressureValue{Pressure: measurements.FromBar(1)}, "pressure limit")
+
+	if limit.String() != measurements.FromBar(1).String() {
+		t.Errorf("default = %v, want %v", limit, measurements.FromBar(1))
+	}
+	if err := fs.Parse([]string{"-limit", "35 psi"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := measurements.FromPoundForcePerSquareInch(35); limit.String() != want.String() {
+		t.Errorf("limit = %v, want %v", limit, want)
+	}
+	if err := fs.Parse([]string{"-limit", "35 furlongs"}); err == nil {
+		t.Error("Parse() expected an error for an unknown unit")
+	}
+}
@@ -0,0 +1,210 @@
+package measurements
+
+//go:generate go run ./cmd/measurementgen -spec quantities.json
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Unit is implemented by every unit type in the package.
+type Unit interface {
+	Kind() Kind
+	String() string
+	Symbol() string
+	Singular() string
+	Plural() string
+	Info() UnitInfo
+}
+
+// System is a set of measurement systems a unit belongs to.
+type System uint8
+
+const (
+	SI System = 1 << iota
+	USCustomary
+	Imperial
+)
+
+var SystemName = map[System]string{
+	SI:          "SI",
+	USCustomary: "US customary",
+	Imperial:    "imperial",
+}
+
+// Has reports whether s includes every system in other.
+func (s System) Has(other System) bool {
+	return s&other == other
+}
+
+func (s System) String() string {
+	if name, ok := SystemName[s]; ok {
+		return name
+	}
+
+	var names []string
+	for _, system := range []System{SI, USCustomary, Imperial} {
+		if s.Has(system) {
+			names = append(names, SystemName[system])
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+func (s Kind) String() string {
+	return KindName[s]
+}
+
+// UnitInfo describes a unit: its names, the systems it belongs to and how
+// it relates to the base unit of its kind.
+type UnitInfo struct {
+	Unit     Unit
+	Kind     Kind
+	Symbol   string
+	Singular string
+	Plural   string
+	System   System
+	Base     bool
+	// Prefixable units accept SI prefixes, such as the gram in milligram.
+	Prefixable bool
+	// Factor and Offset convert a value in this unit to the base unit:
+	// base = value*Factor + Offset.
+	Factor float64
+	Offset float64
+
+	// name is returned by the unit's String method.
+	name string
+	// aliases are further symbols the unit is parsed from.
+	aliases []string
+	goName  string
+	// root and prefix are set on units made by WithPrefix.
+	root   Unit
+	prefix Prefix
+}
+
+// ToBase converts value from this unit to the base unit.
+func (s UnitInfo) ToBase(value float64) float64 {
+	return value*s.Factor + s.Offset
+}
+
+// FromBase converts value from the base unit to this unit.
+func (s UnitInfo) FromBase(value float64) float64 {
+	return (value - s.Offset) / s.Factor
+}
+
+// Units lists every unit of kind, in declaration order followed by the
+// units registered at run time.
+func Units(kind Kind) []UnitInfo {
+	registered := registeredUnits(kind)
+	units := make([]UnitInfo, len(registered))
+	copy(units, registered)
+	return units
+}
+
+// BaseUnit returns the unit every other unit of kind is defined against.
+func BaseUnit(kind Kind) (UnitInfo, bool) {
+	for _, info := range registeredUnits(kind) {
+		if info.Base {
+			return info, true
+		}
+	}
+	return UnitInfo{}, false
+}
+
+// LookupUnit finds a unit of kind by its symbol, or by its singular or
+// plural name ignoring case. A prefixable unit is also found with an SI
+// prefix, such as "hPa" or "milligrams".
+func LookupUnit(kind Kind, s string) (UnitInfo, bool) {
+	if info, ok := lookupSymbol(kind, s); ok {
+		return info, true
+	}
+	for _, info := range registeredUnits(kind) {
+		if strings.EqualFold(info.Singular, s) || strings.EqualFold(info.Plural, s) {
+			return info, true
+		}
+	}
+	return lookupPrefixed(kind, s)
+}
+
+func lookupSymbol(kind Kind, s string) (UnitInfo, bool) {
+	for _, info := range registeredUnits(kind) {
+		if info.hasSymbol(s) {
+			return info, true
+		}
+	}
+	return UnitInfo{}, false
+}
+
+func (s UnitInfo) hasSymbol(symbol string) bool {
+	if s.Symbol == symbol || s.name == symbol {
+		return true
+	}
+	for _, alias := range s.aliases {
+		if alias == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+func lookupUnitInfo(kind Kind, unit Unit) (UnitInfo, bool) {
+	for _, info := range registeredUnits(kind) {
+		if info.Unit == unit {
+			return info, true
+		}
+	}
+	return UnitInfo{}, false
+}
+
+func unitInfo(unit Unit) UnitInfo {
+	info, _ := lookupUnitInfo(unit.Kind(), unit)
+	return info
+}
+
+func unitGoString(unit Unit, typeName string, value int32) string {
+	if info, ok := lookupUnitInfo(unit.Kind(), unit); ok && info.goName != "" {
+		return "measurements." + info.goName
+	}
+	return fmt.Sprintf("measurements.%s(%d)", typeName, value)
+}
+
+// convertValue converts value between two units of kind through the base unit.
+func convertValue(kind Kind, value float64, from, to Unit) (float64, error) {
+	fromInfo, ok := lookupUnitInfo(kind, from)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, from)
+	}
+	toInfo, ok := lookupUnitInfo(kind, to)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, to)
+	}
+	if from == to {
+		return value, nil
+	}
+	if crossesDifferential(from, to) {
+		return 0, fmt.Errorf("%w: %s to %s", ErrDifferential, fromInfo.Symbol, toInfo.Symbol)
+	}
+	return toInfo.FromBase(fromInfo.ToBase(value)), nil
+}
+
+// closestUnit finds the unit of kind in system whose size is nearest to
+// from, keeping the reference of a gauge or differential pressure.
+func closestUnit(kind Kind, from UnitInfo, system System) (UnitInfo, bool) {
+	var (
+		best     UnitInfo
+		distance = math.Inf(1)
+	)
+	for _, info := range registeredUnits(kind) {
+		if !info.System.Has(system) || !sameReference(info.Unit, from.Unit) {
+			continue
+		}
+		if d := math.Abs(math.Log(math.Abs(info.Factor / from.Factor))); d < distance {
+			best, distance = info, d
+		}
+	}
+	return best, !math.IsInf(distance, 1)
+}
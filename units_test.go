@@ -0,0 +1,162 @@
+package measurements_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_Units(t *testing.T) {
+	tests := []struct {
+		name string
+		kind measurements.Kind
+		want []measurements.Unit
+	}{
+		{
+			name: "Mass",
+			kind: measurements.MassKind,
+			want: []measurements.Unit{measurements.Kilogram, measurements.Gram, measurements.Pound, measurements.Ounce, measurements.Tonne, measurements.Stone, measurements.Milligram, measurements.Microgram, measurements.ShortTon, measurements.LongTon, measurements.Grain, measurements.Dram, measurements.TroyOunce, measurements.TroyPound, measurements.Pennyweight, measurements.Carat, measurements.AtomicMassUnit, measurements.Slug},
+		},
+		{
+			name: "Pressure",
+			kind: measurements.PressureKind,
+			want: []measurements.Unit{measurements.Torr, measurements.Bar, measurements.Pascal, measurements.PoundForcePerSquareInch, measurements.PoundForcePerSquareInchGauge, measurements.BarGauge, measurements.PoundForcePerSquareInchDifferential, measurements.Atmosphere, measurements.TechnicalAtmosphere, measurements.MillimetreOfMercury, measurements.InchOfMercury, measurements.CentimetreOfWater, measurements.InchOfWater, measurements.Millibar, measurements.Hectopascal, measurements.Kilopascal, measurements.Megapascal, measurements.KilopoundForcePerSquareInch},
+		},
+		{
+			name: "Temperature",
+			kind: measurements.TemperatureKind,
+			want: []measurements.Unit{measurements.Celsius, measurements.Fahrenheit, measurements.Kelvin, measurements.Rankine, measurements.Reaumur, measurements.Romer, measurements.Delisle, measurements.Newton},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []measurements.Unit
+			for _, info := range measurements.Units(tt.kind) {
+				got = append(got, info.Unit)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Units() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_BaseUnit(t *testing.T) {
+	tests := []struct {
+		name string
+		kind measurements.Kind
+		want measurements.Unit
+	}{
+		{name: "Mass", kind: measurements.MassKind, want: measurements.Kilogram},
+		{name: "Pressure", kind: measurements.PressureKind, want: measurements.Pascal},
+		{name: "Volume", kind: measurements.VolumeKind, want: measurements.Litre},
+		{name: "Temperature", kind: measurements.TemperatureKind, want: measurements.Kelvin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := measurements.BaseUnit(tt.kind)
+			if !ok || got.Unit != tt.want || got.Factor != 1 {
+				t.Errorf("BaseUnit() = %v, want %v", got.Unit, tt.want)
+			}
+		})
+	}
+}
+
+func Test_LookupUnit(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  measurements.Kind
+		input string
+		want  measurements.Unit
+		ok    bool
+	}{
+		{name: "Symbol", kind: measurements.MassKind, input: "lb", want: measurements.Pound, ok: true},
+		{name: "Singular", kind: measurements.MassKind, input: "Kilogram", want: measurements.Kilogram, ok: true},
+		{name: "Plural", kind: measurements.VolumeKind, input: "imperial pints", want: measurements.ImperialPint, ok: true},
+		{name: "Degree symbol", kind: measurements.TemperatureKind, input: "°F", want: measurements.Fahrenheit, ok: true},
+		{name: "Bare symbol", kind: measurements.TemperatureKind, input: "F", want: measurements.Fahrenheit, ok: true},
+		{name: "Wrong kind", kind: measurements.PressureKind, input: "kg", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := measurements.LookupUnit(tt.kind, tt.input)
+			if ok != tt.ok || got.Unit != tt.want {
+				t.Errorf("LookupUnit() = %v, %v, want %v, %v", got.Unit, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func Test_UnitInfo_ToBase(t *testing.T) {
+	tests := []struct {
+		name  string
+		unit  measurements.Unit
+		value float64
+		want  float64
+	}{
+		{name: "Pound", unit: measurements.Pound, value: 1, want: 0.45359237},
+		{name: "Bar", unit: measurements.Bar, value: 1.01325, want: 101325},
+		{name: "Imperial gallon", unit: measurements.ImperialGallon, value: 1, want: 4.54609},
+		{name: "Celsius", unit: measurements.Celsius, value: 0, want: 273.15},
+		{name: "Fahrenheit", unit: measurements.Fahrenheit, value: 32, want: 273.15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := tt.unit.Info()
+			if got := info.ToBase(tt.value); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ToBase() = %v, want %v", got, tt.want)
+			}
+			if got := info.FromBase(info.ToBase(tt.value)); math.Abs(got-tt.value) > 1e-9 {
+				t.Errorf("FromBase() = %v, want %v", got, tt.value)
+			}
+		})
+	}
+}
+
+// The factors are the legal definitions, not the rounded ones the
+// conversion methods once used, such as 454 g to the pound or 133 Pa to the
+// torr.
+func Test_UnitInfo_Factor(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.Unit
+		want float64
+	}{
+		{name: "Pound", unit: measurements.Pound, want: 0.45359237},
+		{name: "Ounce", unit: measurements.Ounce, want: 0.45359237 / 16},
+		{name: "Torr", unit: measurements.Torr, want: 101325.0 / 760},
+		{name: "Pound-force per square inch", unit: measurements.PoundForcePerSquareInch, want: 0.45359237 * 9.80665 / (0.0254 * 0.0254)},
+		{name: "US liquid gallon", unit: measurements.USLiquidGallon, want: 231 * 0.0254 * 0.0254 * 0.0254 * 1000},
+		{name: "US fluid ounce", unit: measurements.USfluidOunce, want: 231 * 0.0254 * 0.0254 * 0.0254 * 1000 / 128},
+		{name: "Imperial gallon", unit: measurements.ImperialGallon, want: 4.54609},
+		{name: "Imperial fluid ounce", unit: measurements.ImperialFluidOunce, want: 4.54609 / 160},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.unit.Info().Factor; math.Abs(got-tt.want) > 1e-12*tt.want {
+				t.Errorf("Factor = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_System_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		system measurements.System
+		want   string
+	}{
+		{name: "Single", system: measurements.SI, want: "SI"},
+		{name: "Combined", system: measurements.USCustomary | measurements.Imperial, want: "US customary|imperial"},
+		{name: "None", system: 0, want: "none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.system.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package measurements
+
+import (
+	"strings"
+	"sync"
+)
+
+// UnitSystem is a profile of the units a user prefers, such as "metric",
+// "US" or "UK".
+type UnitSystem struct {
+	// Name identifies the profile for LookupUnitSystem, ignoring case.
+	Name string
+	// System is used for kinds missing from Units: measurements convert to
+	// the unit of System closest in size to their own.
+	System System
+	// Units holds the preferred unit of each kind.
+	Units map[Kind]Unit
+}
+
+var (
+	MetricUnits = UnitSystem{
+		Name:   "metric",
+		System: SI,
+		Units: map[Kind]Unit{
+			MassKind:        Kilogram,
+			PressureKind:    Bar,
+			VolumeKind:      Litre,
+			TemperatureKind: Celsius,
+		},
+	}
+	USUnits = UnitSystem{
+		Name:   "US",
+		System: USCustomary,
+		Units: map[Kind]Unit{
+			MassKind:        Pound,
+			PressureKind:    PoundForcePerSquareInch,
+			VolumeKind:      USLiquidGallon,
+			TemperatureKind: Fahrenheit,
+		},
+	}
+	// UKUnits mixes imperial body mass and drink measures with the metric
+	// Celsius scale.
+	UKUnits = UnitSystem{
+		Name:   "UK",
+		System: Imperial,
+		Units: map[Kind]Unit{
+			MassKind:        Stone,
+			PressureKind:    PoundForcePerSquareInch,
+			VolumeKind:      ImperialPint,
+			TemperatureKind: Celsius,
+		},
+	}
+)
+
+var (
+	unitSystemsMu sync.RWMutex
+	unitSystems   = map[string]UnitSystem{}
+)
+
+func init() {
+	for _, system := range []UnitSystem{MetricUnits, USUnits, UKUnits} {
+		RegisterUnitSystem(system)
+	}
+}
+
+// RegisterUnitSystem adds system to the table used by LookupUnitSystem,
+// replacing any system with the same name.
+func RegisterUnitSystem(system UnitSystem) {
+	unitSystemsMu.Lock()
+	defer unitSystemsMu.Unlock()
+	unitSystems[strings.ToLower(system.Name)] = system
+}
+
+// LookupUnitSystem finds the unit system registered as name, ignoring case.
+func LookupUnitSystem(name string) (*UnitSystem, bool) {
+	unitSystemsMu.RLock()
+	defer unitSystemsMu.RUnlock()
+	system, ok := unitSystems[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	return &system, true
+}
+
+// Unit returns the unit of kind that measurements in from convert to. A
+// gauge or differential pressure converts to a unit with the same
+// reference, so psig becomes barg rather than bar.
+func (s *UnitSystem) Unit(kind Kind, from Unit) (Unit, bool) {
+	if unit, ok := s.Units[kind]; ok && unit != nil && unit.Kind() == kind && sameReference(unit, from) {
+		return unit, true
+	}
+	if s.System == 0 {
+		return nil, false
+	}
+	info, ok := lookupUnitInfo(kind, from)
+	if !ok {
+		return nil, false
+	}
+	if info.System.Has(s.System) {
+		return from, true
+	}
+	closest, ok := closestUnit(kind, info, s.System)
+	return closest.Unit, ok
+}
+
+// converter is implemented by the measurements ConvertToSystem can rebuild
+// in another unit while keeping their type.
+type converter interface {
+	convertedTo(unit Unit, value float64) Measurement
+}
+
+// ConvertToSystem converts m to the unit system prefers for its kind. It
+// returns m unchanged when system has no unit for it, or when M is not a
+// quantity, a quantity interface such as Mass or a value wrapper such as
+// MassValue.
+func ConvertToSystem[M Measurement](m M, system *UnitSystem) M {
+	from := m.measurementUnit()
+	kind := from.Kind()
+	to, ok := system.Unit(kind, from)
+	if !ok || to == from {
+		return m
+	}
+	value, err := convertValue(kind, m.Value(), from, to)
+	if err != nil {
+		return m
+	}
+	c, ok := any(m).(converter)
+	if !ok {
+		return m
+	}
+	converted, ok := c.convertedTo(to, value).(M)
+	if !ok {
+		return m
+	}
+	return converted
+}
+
+func (s MassValue) convertedTo(unit Unit, value float64) Measurement {
+	s.Mass = NewMass(unit.(MassUnit), value)
+	return s
+}
+
+func (s PressureValue) convertedTo(unit Unit, value float64) Measurement {
+	s.Pressure = NewPressure(unit.(PressureUnit), value)
+	return s
+}
+
+func (s VolumeValue) convertedTo(unit Unit, value float64) Measurement {
+	s.Volume = NewVolume(unit.(VolumeType), value)
+	return s
+}
+
+func (s TemperatureValue) convertedTo(unit Unit, value float64) Measurement {
+	s.Temperature = NewTemperature(unit.(TemperatureUnit), value)
+	return s
+}
@@ -0,0 +1,162 @@
+package measurements_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_ConvertToSystem(t *testing.T) {
+	tests := []struct {
+		name   string
+		system string
+		m      measurements.Measurement
+		want   string
+	}{
+		{
+			name:   "Metric mass",
+			system: "metric",
+			m:      measurements.FromPound(22.0462262185),
+			want:   "10.00 kg",
+		},
+		{
+			name:   "US temperature",
+			system: "US",
+			m:      measurements.FromCelsius(100),
+			want:   "212.00 °F",
+		},
+		{
+			name:   "Metric from Delisle",
+			system: "metric",
+			m:      measurements.FromDelisle(94.5),
+			want:   "37.00 °C",
+		},
+		{
+			name:   "UK body mass",
+			system: "uk",
+			m:      measurements.FromKilogram(63.5029318),
+			want:   "10.00 st",
+		},
+		{
+			name:   "UK beer",
+			system: "UK",
+			m:      measurements.FromMilliliter(568.26125),
+			want:   "1.00 imp pt",
+		},
+		{
+			name:   "UK temperature",
+			system: "UK",
+			m:      measurements.FromFahrenheit(212),
+			want:   "100.00 °C",
+		},
+		{
+			name:   "US gauge pressure",
+			system: "US",
+			m:      measurements.NewPressure(measurements.BarGauge, 2),
+			want:   "29.01 psig",
+		},
+		{
+			name:   "Metric gauge pressure",
+			system: "metric",
+			m:      measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, 29.007547546),
+			want:   "2.00 barg",
+		},
+		{
+			name:   "Differential pressure",
+			system: "metric",
+			m:      measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, 5),
+			want:   "5.00 psid",
+		},
+		{
+			name:   "US pressure",
+			system: "US",
+			m:      measurements.FromBar(1),
+			want:   "14.50 psi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			system, ok := measurements.LookupUnitSystem(tt.system)
+			if !ok {
+				t.Fatalf("LookupUnitSystem(%q) not found", tt.system)
+			}
+			if got := measurements.ConvertToSystem(tt.m, system); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("ConvertToSystem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ConvertToSystem_types(t *testing.T) {
+	q := measurements.ConvertToSystem(measurements.NewQuantity(measurements.Gram, 1000), &measurements.USUnits)
+	if got, want := q.String(), "2.20 lb"; got != want {
+		t.Errorf("ConvertToSystem(Quantity) = %v, want %v", got, want)
+	}
+
+	v := measurements.ConvertToSystem(measurements.MassValue{Mass: measurements.FromGram(1000), Compact: true}, &measurements.USUnits)
+	if got, want := v.String(), "2.20 lb"; got != want || !v.Compact {
+		t.Errorf("ConvertToSystem(MassValue) = %+v, want %v", v, want)
+	}
+
+	p := measurements.ConvertToSystem(measurements.PressureValue{Pressure: measurements.FromPascal(101325)}, &measurements.USUnits)
+	if got, want := p.String(), "14.70 psi"; got != want {
+		t.Errorf("ConvertToSystem(PressureValue) = %v, want %v", got, want)
+	}
+}
+
+func Test_ConvertToSystem_typed(t *testing.T) {
+	var m measurements.Mass = measurements.FromKilogram(1)
+	got := measurements.ConvertToSystem(m, &measurements.USUnits)
+	if got.Unit() != measurements.Pound {
+		t.Errorf("ConvertToSystem() = %v, want pounds", got)
+	}
+}
+
+func Test_RegisterUnitSystem(t *testing.T) {
+	measurements.RegisterUnitSystem(measurements.UnitSystem{
+		Name:   "laboratory",
+		System: measurements.SI,
+		Units: map[measurements.Kind]measurements.Unit{
+			measurements.TemperatureKind: measurements.Kelvin,
+			measurements.PressureKind:    measurements.Pascal,
+		},
+	})
+	system, ok := measurements.LookupUnitSystem("Laboratory")
+	if !ok {
+		t.Fatal("LookupUnitSystem() not found")
+	}
+
+	tests := []struct {
+		name string
+		m    measurements.Measurement
+		want string
+	}{
+		{
+			name: "Preferred unit",
+			m:    measurements.FromCelsius(25),
+			want: "298.15 K",
+		},
+		{
+			name: "Closest unit of the system",
+			m:    measurements.FromOunce(3.527396195),
+			want: "100.00 g",
+		},
+		{
+			name: "Already in the system",
+			m:    measurements.FromMilliliter(250),
+			want: "250.00 ml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := measurements.ConvertToSystem(tt.m, system); !reflect.DeepEqual(got.String(), tt.want) {
+				t.Errorf("ConvertToSystem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := measurements.LookupUnitSystem("imperial-ish"); ok {
+		t.Error("LookupUnitSystem() found an unregistered system")
+	}
+}
@@ -0,0 +1,69 @@
+package measurements
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrNaN               = errors.New("measurements: value is NaN")
+	ErrInfinite          = errors.New("measurements: value is infinite")
+	ErrNegative          = errors.New("measurements: value is negative")
+	ErrBelowAbsoluteZero = errors.New("measurements: temperature is below absolute zero")
+)
+
+// ValueError reports a measurement whose value is not physically possible.
+// Err is one of ErrNaN, ErrInfinite, ErrNegative or ErrBelowAbsoluteZero.
+type ValueError struct {
+	Kind  Kind
+	Unit  Unit
+	Value float64
+	Err   error
+}
+
+func (s *ValueError) Error() string {
+	return fmt.Sprintf("%v: %s %v %s", s.Err, s.Kind, s.Value, s.Unit.Symbol())
+}
+
+func (s *ValueError) Unwrap() error {
+	return s.Err
+}
+
+// limit is the smallest physically possible value of a kind.
+type limit struct {
+	min float64
+	err error
+}
+
+// Validate reports whether the quantity is physically possible, returning
+// a *ValueError if it is not.
+func (s Quantity[U]) Validate() error {
+	return validateValue(s.unit, s.value)
+}
+
+func validateValue(unit Unit, value float64) error {
+	kind := unit.Kind()
+	switch {
+	case math.IsNaN(value):
+		return &ValueError{Kind: kind, Unit: unit, Value: value, Err: ErrNaN}
+	case math.IsInf(value, 0):
+		return &ValueError{Kind: kind, Unit: unit, Value: value, Err: ErrInfinite}
+	}
+
+	info, ok := lookupUnitInfo(kind, unit)
+	if !ok {
+		return fmt.Errorf("%w: %s %#v", ErrUnknownUnit, kind, unit)
+	}
+	if unit, isPressure := unit.(PressureUnit); isPressure && unit.Reference() == Differential {
+		// A difference has no zero point to fall below.
+		return nil
+	}
+	limit, ok := kindLimits[kind]
+	// The tolerance allows for rounding in the conversion, so -459.67 °F is
+	// still absolute zero.
+	if ok && info.ToBase(value) < limit.min-1e-9 {
+		return &ValueError{Kind: kind, Unit: unit, Value: value, Err: limit.err}
+	}
+	return nil
+}
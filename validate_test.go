@@ -0,0 +1,147 @@
+package measurements_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_NewChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		new     func() (measurements.Measurement, error)
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Absolute zero",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewTemperatureChecked(measurements.Celsius, -273.15)
+			},
+			want: "-273.15 °C",
+		},
+		{
+			name: "Absolute zero in Fahrenheit",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewTemperatureChecked(measurements.Fahrenheit, -459.67)
+			},
+			want: "-459.67 °F",
+		},
+		{
+			name: "Below absolute zero in Kelvin",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewTemperatureChecked(measurements.Kelvin, -5)
+			},
+			wantErr: measurements.ErrBelowAbsoluteZero,
+		},
+		{
+			name: "Below absolute zero in Celsius",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewTemperatureChecked(measurements.Celsius, -300)
+			},
+			wantErr: measurements.ErrBelowAbsoluteZero,
+		},
+		{
+			name: "Negative mass",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewMassChecked(measurements.Pound, -1)
+			},
+			wantErr: measurements.ErrNegative,
+		},
+		{
+			name: "Negative volume",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewVolumeChecked(measurements.Litre, -0.5)
+			},
+			wantErr: measurements.ErrNegative,
+		},
+		{
+			name: "Negative pressure",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewPressureChecked(measurements.Pascal, -1)
+			},
+			wantErr: measurements.ErrNegative,
+		},
+		{
+			name: "NaN",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewMassChecked(measurements.Kilogram, math.NaN())
+			},
+			wantErr: measurements.ErrNaN,
+		},
+		{
+			name: "Positive infinity",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewVolumeChecked(measurements.Litre, math.Inf(1))
+			},
+			wantErr: measurements.ErrInfinite,
+		},
+		{
+			name: "Negative infinity",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewTemperatureChecked(measurements.Kelvin, math.Inf(-1))
+			},
+			wantErr: measurements.ErrInfinite,
+		},
+		{
+			name: "Zero mass",
+			new: func() (measurements.Measurement, error) {
+				return measurements.NewMassChecked(measurements.Gram, 0)
+			},
+			want: "0.00 g",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.new()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("New() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	if err := measurements.FromCelsius(20).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	err := measurements.FromKelvin(-1).Validate()
+	var valueErr *measurements.ValueError
+	if !errors.As(err, &valueErr) {
+		t.Fatalf("Validate() error = %v, want a *ValueError", err)
+	}
+	if valueErr.Kind != measurements.TemperatureKind || valueErr.Unit != measurements.Kelvin || valueErr.Value != -1 {
+		t.Errorf("Validate() error = %+v", valueErr)
+	}
+	if want := "measurements: temperature is below absolute zero: temperature -1 K"; err.Error() != want {
+		t.Errorf("Error() = %v, want %v", err, want)
+	}
+}
+
+func Test_Validate_pressureReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       measurements.Pressure
+		wantErr error
+	}{
+		{name: "Partial vacuum", m: measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, -10)},
+		{name: "Below a vacuum", m: measurements.NewPressure(measurements.PoundForcePerSquareInchGauge, -20), wantErr: measurements.ErrNegative},
+		{name: "Negative difference", m: measurements.NewPressure(measurements.PoundForcePerSquareInchDifferential, -20)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.m.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements
+
+type VolumeType int32
+
+const (
+	Milliliter VolumeType = iota
+	Litre
+	USfluidOunce
+	USlegalCup
+	USliquidPint
+	USLiquidQuart
+	USLiquidGallon
+	ImperialFluidOunce
+	ImperialCup
+	ImperialPint
+	ImperialQuart
+	ImperialGallon
+)
+
+// InvalidVolumeType is returned by RegisterVolumeType on error. It is
+// never a volume unit, so IsValid reports false and ConvertTo and the
+// encodings reject it.
+const InvalidVolumeType VolumeType = -1
+
+// VolumeTypeName and VolumeTypeValue hold the built-in volume units only; units
+// added by RegisterVolumeType are found through LookupUnit.
+var VolumeTypeName = map[VolumeType]string{
+	Milliliter:         "ml",
+	Litre:              "l",
+	USfluidOunce:       "fl oz",
+	USlegalCup:         "cp",
+	USliquidPint:       "pt",
+	USLiquidQuart:      "qt",
+	USLiquidGallon:     "gal",
+	ImperialFluidOunce: "imp fl oz",
+	ImperialCup:        "imp cp",
+	ImperialPint:       "imp pt",
+	ImperialQuart:      "imp qt",
+	ImperialGallon:     "imp gal",
+}
+
+var VolumeTypeValue = map[string]VolumeType{
+	"ml":        Milliliter,
+	"l":         Litre,
+	"fl oz":     USfluidOunce,
+	"cp":        USlegalCup,
+	"pt":        USliquidPint,
+	"qt":        USLiquidQuart,
+	"gal":       USLiquidGallon,
+	"imp fl oz": ImperialFluidOunce,
+	"imp cp":    ImperialCup,
+	"imp pt":    ImperialPint,
+	"imp qt":    ImperialQuart,
+	"imp gal":   ImperialGallon,
+}
+var volumeUnits = []UnitInfo{
+	{Unit: Milliliter, Kind: VolumeKind, Symbol: "ml", Singular: "millilitre", Plural: "millilitres", System: SI, Factor: 0.001, name: "ml", goName: "Milliliter"},
+	{Unit: Litre, Kind: VolumeKind, Symbol: "l", Singular: "litre", Plural: "litres", System: SI, Base: true, Prefixable: true, Factor: 1, name: "l", aliases: []string{"L"}, goName: "Litre"},
+	{Unit: USfluidOunce, Kind: VolumeKind, Symbol: "fl oz", Singular: "US fluid ounce", Plural: "US fluid ounces", System: USCustomary, Factor: 0.0295735295625, name: "fl oz", goName: "USfluidOunce"},
+	{Unit: USlegalCup, Kind: VolumeKind, Symbol: "cp", Singular: "US legal cup", Plural: "US legal cups", System: USCustomary, Factor: 0.24, name: "cp", goName: "USlegalCup"},
+	{Unit: USliquidPint, Kind: VolumeKind, Symbol: "pt", Singular: "US liquid pint", Plural: "US liquid pints", System: USCustomary, Factor: 0.473176473, name: "pt", goName: "USliquidPint"},
+	{Unit: USLiquidQuart, Kind: VolumeKind, Symbol: "qt", Singular: "US liquid quart", Plural: "US liquid quarts", System: USCustomary, Factor: 0.946352946, name: "qt", goName: "USLiquidQuart"},
+	{Unit: USLiquidGallon, Kind: VolumeKind, Symbol: "gal", Singular: "US liquid gallon", Plural: "US liquid gallons", System: USCustomary, Factor: 3.785411784, name: "gal", goName: "USLiquidGallon"},
+	{Unit: ImperialFluidOunce, Kind: VolumeKind, Symbol: "imp fl oz", Singular: "imperial fluid ounce", Plural: "imperial fluid ounces", System: Imperial, Factor: 0.0284130625, name: "imp fl oz", goName: "ImperialFluidOunce"},
+	{Unit: ImperialCup, Kind: VolumeKind, Symbol: "imp cp", Singular: "imperial cup", Plural: "imperial cups", System: Imperial, Factor: 0.284130625, name: "imp cp", goName: "ImperialCup"},
+	{Unit: ImperialPint, Kind: VolumeKind, Symbol: "imp pt", Singular: "imperial pint", Plural: "imperial pints", System: Imperial, Factor: 0.56826125, name: "imp pt", goName: "ImperialPint"},
+	{Unit: ImperialQuart, Kind: VolumeKind, Symbol: "imp qt", Singular: "imperial quart", Plural: "imperial quarts", System: Imperial, Factor: 1.1365225, name: "imp qt", goName: "ImperialQuart"},
+	{Unit: ImperialGallon, Kind: VolumeKind, Symbol: "imp gal", Singular: "imperial gallon", Plural: "imperial gallons", System: Imperial, Factor: 4.54609, name: "imp gal", goName: "ImperialGallon"},
+}
+
+func (s VolumeType) Kind() Kind {
+	return VolumeKind
+}
+
+func (s VolumeType) String() string {
+	return unitInfo(s).name
+}
+
+func (s VolumeType) Symbol() string {
+	return s.Info().Symbol
+}
+
+func (s VolumeType) Singular() string {
+	return s.Info().Singular
+}
+
+func (s VolumeType) Plural() string {
+	return s.Info().Plural
+}
+
+func (s VolumeType) Info() UnitInfo {
+	return unitInfo(s)
+}
+
+func (s VolumeType) GoString() string {
+	return unitGoString(s, "VolumeType", int32(s))
+}
+
+// IsValid reports whether s is a built-in or registered volume unit.
+func (s VolumeType) IsValid() bool {
+	_, ok := lookupUnitInfo(VolumeKind, s)
+	return ok
+}
+
+type Volume interface {
+	Measurement
+
+	Unit() VolumeType
+	Value() float64
+	String() string
+
+	Validate() error
+
+	To(unit VolumeType) Volume
+	ConvertTo(unit VolumeType) (Volume, error)
+	ToMilliliter() Volume
+	ToLiter() Volume
+	ToUSfluidOunce() Volume
+	ToUSlegalCup() Volume
+	ToUSliquidPint() Volume
+	ToUSLiquidQuart() Volume
+	ToUSLiquidGallon() Volume
+	ToImperialFluidOunce() Volume
+	ToImperialCup() Volume
+	ToImperialPint() Volume
+	ToImperialQuart() Volume
+	ToImperialGallon() Volume
+}
+
+type volume struct {
+	Quantity[VolumeType]
+}
+
+// RegisterVolumeType adds a volume unit at run time. The unit works with
+// To, parsing and formatting like the built-in units, but its enum value
+// depends on the order of registration, so it cannot be written by
+// MarshalBinary or an Encoder. On error it returns InvalidVolumeType.
+func RegisterVolumeType(def UnitDefinition) (VolumeType, error) {
+	info, err := registerUnit(VolumeKind, def)
+	if err != nil {
+		return InvalidVolumeType, err
+	}
+	return info.Unit.(VolumeType), nil
+}
+
+func NewVolume(unit VolumeType, value float64) Volume {
+	return &volume{NewQuantity(unit, value)}
+}
+
+// NewVolumeChecked is NewVolume for untrusted values: it returns a
+// *ValueError when value is NaN, infinite or physically impossible.
+func NewVolumeChecked(unit VolumeType, value float64) (Volume, error) {
+	m := NewVolume(unit, value)
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func FromMilliliter(value float64) Volume {
+	return NewVolume(Milliliter, value)
+}
+
+func FromLiter(value float64) Volume {
+	return NewVolume(Litre, value)
+}
+
+func FromUSfluidOunce(value float64) Volume {
+	return NewVolume(USfluidOunce, value)
+}
+
+func FromUSlegalCup(value float64) Volume {
+	return NewVolume(USlegalCup, value)
+}
+
+func FromUSliquidPint(value float64) Volume {
+	return NewVolume(USliquidPint, value)
+}
+
+func FromUSLiquidQuart(value float64) Volume {
+	return NewVolume(USLiquidQuart, value)
+}
+
+func FromUSLiquidGallon(value float64) Volume {
+	return NewVolume(USLiquidGallon, value)
+}
+
+func FromImperialFluidOunce(value float64) Volume {
+	return NewVolume(ImperialFluidOunce, value)
+}
+
+func FromImperialCup(value float64) Volume {
+	return NewVolume(ImperialCup, value)
+}
+
+func FromImperialPint(value float64) Volume {
+	return NewVolume(ImperialPint, value)
+}
+
+func FromImperialQuart(value float64) Volume {
+	return NewVolume(ImperialQuart, value)
+}
+
+func FromImperialGallon(value float64) Volume {
+	return NewVolume(ImperialGallon, value)
+}
+
+func ParseVolume(s string) (Volume, error) {
+	value, info, err := parseMeasurement(VolumeKind, s)
+	if err != nil {
+		return nil, err
+	}
+	return NewVolume(info.Unit.(VolumeType), value), nil
+}
+
+// BestVolumeType returns the unit that shows m most readably, as chosen
+// by BestUnit.
+func BestVolumeType(m Volume, opts HumanizeOptions) VolumeType {
+	return BestUnit(m, opts).(VolumeType)
+}
+
+func (s *volume) To(unit VolumeType) Volume {
+	return &volume{s.Quantity.To(unit)}
+}
+
+func (s *volume) convertedTo(unit Unit, value float64) Measurement {
+	return NewVolume(unit.(VolumeType), value)
+}
+
+func (s *volume) ConvertTo(unit VolumeType) (Volume, error) {
+	q, err := s.Quantity.ConvertTo(unit)
+	if err != nil {
+		return nil, err
+	}
+	return &volume{q}, nil
+}
+
+func (s *volume) ToMilliliter() Volume {
+	return s.To(Milliliter)
+}
+
+func (s *volume) ToLiter() Volume {
+	return s.To(Litre)
+}
+
+func (s *volume) ToUSfluidOunce() Volume {
+	return s.To(USfluidOunce)
+}
+
+func (s *volume) ToUSlegalCup() Volume {
+	return s.To(USlegalCup)
+}
+
+func (s *volume) ToUSliquidPint() Volume {
+	return s.To(USliquidPint)
+}
+
+func (s *volume) ToUSLiquidQuart() Volume {
+	return s.To(USLiquidQuart)
+}
+
+func (s *volume) ToUSLiquidGallon() Volume {
+	return s.To(USLiquidGallon)
+}
+
+func (s *volume) ToImperialFluidOunce() Volume {
+	return s.To(ImperialFluidOunce)
+}
+
+func (s *volume) ToImperialCup() Volume {
+	return s.To(ImperialCup)
+}
+
+func (s *volume) ToImperialPint() Volume {
+	return s.To(ImperialPint)
+}
+
+func (s *volume) ToImperialQuart() Volume {
+	return s.To(ImperialQuart)
+}
+
+func (s *volume) ToImperialGallon() Volume {
+	return s.To(ImperialGallon)
+}
@@ -0,0 +1,98 @@
+// Code generated by measurementgen from quantities.json. DO NOT EDIT.
+
+package measurements_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/RossMerr/go-measurements"
+)
+
+func Test_volume_generated(t *testing.T) {
+	tests := []struct {
+		name string
+		unit measurements.VolumeType
+		// base is one of unit in litres.
+		base float64
+	}{
+		{
+			name: "Milliliter",
+			unit: measurements.Milliliter,
+			base: 0.001,
+		},
+		{
+			name: "Litre",
+			unit: measurements.Litre,
+			base: 1,
+		},
+		{
+			name: "USfluidOunce",
+			unit: measurements.USfluidOunce,
+			base: 0.0295735295625,
+		},
+		{
+			name: "USlegalCup",
+			unit: measurements.USlegalCup,
+			base: 0.24,
+		},
+		{
+			name: "USliquidPint",
+			unit: measurements.USliquidPint,
+			base: 0.473176473,
+		},
+		{
+			name: "USLiquidQuart",
+			unit: measurements.USLiquidQuart,
+			base: 0.946352946,
+		},
+		{
+			name: "USLiquidGallon",
+			unit: measurements.USLiquidGallon,
+			base: 3.785411784,
+		},
+		{
+			name: "ImperialFluidOunce",
+			unit: measurements.ImperialFluidOunce,
+			base: 0.0284130625,
+		},
+		{
+			name: "ImperialCup",
+			unit: measurements.ImperialCup,
+			base: 0.284130625,
+		},
+		{
+			name: "ImperialPint",
+			unit: measurements.ImperialPint,
+			base: 0.56826125,
+		},
+		{
+			name: "ImperialQuart",
+			unit: measurements.ImperialQuart,
+			base: 1.1365225,
+		},
+		{
+			name: "ImperialGallon",
+			unit: measurements.ImperialGallon,
+			base: 4.54609,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			one := measurements.NewVolume(tt.unit, 1)
+			if got, want := one.ToLiter(), measurements.FromLiter(tt.base); !reflect.DeepEqual(got.String(), want.String()) {
+				t.Errorf("ToLiter() = %v, want %v", got, want)
+			}
+			if got := one.ToLiter().To(tt.unit); !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ToLiter().To() = %v, want %v", got, one)
+			}
+			got, err := measurements.ParseVolume(one.String())
+			if err != nil {
+				t.Fatalf("ParseVolume() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.String(), one.String()) {
+				t.Errorf("ParseVolume() = %v, want %v", got, one)
+			}
+		})
+	}
+}
@@ -345,7 +345,7 @@ func Test_volume_ToUSfluidOunce(t *testing.T) {
 				unit:  measurements.ImperialGallon,
 				value: 0.0650527,
 			},
-			want: measurements.FromUSfluidOunce(10.02),
+			want: measurements.FromUSfluidOunce(10),
 		},
 	}
 	for _, tt := range tests {
@@ -781,7 +781,7 @@ func Test_volume_ToUSLiquidGallon(t *testing.T) {
 				unit:  measurements.ImperialFluidOunce,
 				value: 1332.28,
 			},
-			want: measurements.FromUSLiquidGallon(10.02),
+			want: measurements.FromUSLiquidGallon(10),
 		},
 		{
 			name: "ImperialCup to USLiquidGallon",
@@ -890,7 +890,7 @@ func Test_volume_ToImperialFluidOunce(t *testing.T) {
 				unit:  measurements.USLiquidGallon,
 				value: 0.0750594,
 			},
-			want: measurements.FromImperialFluidOunce(9.98),
+			want: measurements.FromImperialFluidOunce(10),
 		},
 		{
 			name: "ImperialFluidOunce to ImperialFluidOunce",
@@ -1326,7 +1326,7 @@ func Test_volume_ToImperialGallon(t *testing.T) {
 				unit:  measurements.USfluidOunce,
 				value: 1537.22,
 			},
-			want: measurements.FromImperialGallon(9.98),
+			want: measurements.FromImperialGallon(10),
 		},
 		{
 			name: "USlegalCup to ImperialGallon",